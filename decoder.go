@@ -17,8 +17,12 @@
 package modfile_lib
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"slices"
 
 	v1_generator "github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator"
 	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
@@ -26,31 +30,180 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-func Unmarshal(b []byte) (module_lib.Module, error) {
+// ErrMaxSize is returned if a modfile exceeds the size set via WithMaxSize.
+var ErrMaxSize = errors.New("modfile exceeds maximum size")
+
+// ErrEmpty is returned by Unmarshal and Decode if the input does not contain a modfile.
+var ErrEmpty = errors.New("modfile empty")
+
+// LimitError is returned if a modfile exceeds a limit set via options, e.g. WithMaxSize or WithMaxNodes.
+type LimitError = v1_model.LimitError
+
+// Decoder reads and decodes modfiles from an input stream.
+type Decoder struct {
+	r      *sizeReader
+	dec    *yaml.Decoder
+	opt    options
+	isJSON bool
+	err    error
+}
+
+// NewDecoder returns a Decoder reading from r and applying the provided options.
+func NewDecoder(r io.Reader, opts ...Option) *Decoder {
+	d := &Decoder{opt: newOptions(opts)}
+	d.r = &sizeReader{r: r, max: d.opt.maxSize}
+	d.dec = yaml.NewDecoder(d.r)
+	return d
+}
+
+// Decode reads the next modfile from the input and returns the resulting module.
+// Empty documents are skipped and io.EOF is returned once the input is exhausted.
+// Errors of the underlying stream are permanent, all further calls return the same error.
+func (d *Decoder) Decode() (module_lib.Module, error) {
+	if d.err != nil {
+		return module_lib.Module{}, d.err
+	}
+	d.r.n = 0
 	var nw nodeWrapper
-	err := yaml.Unmarshal(b, &nw)
+	for nw.Node == nil {
+		if err := d.dec.Decode(&nw); err != nil {
+			if d.r.exceeded {
+				err = sizeError(d.opt.maxSize)
+			}
+			d.err = err
+			return module_lib.Module{}, err
+		}
+	}
+	return getModule(nw.Version, nw.Node, d.opt, d.isJSON)
+}
+
+// DocumentError records an error and the zero based index of the document in a stream that caused it.
+// Empty documents are not counted.
+type DocumentError struct {
+	Index int
+	Err   error
+}
+
+func (e *DocumentError) Error() string {
+	return fmt.Sprintf("document %d: %s", e.Index, e.Err)
+}
+
+func (e *DocumentError) Unwrap() error {
+	return e.Err
+}
+
+// Unmarshal decodes a single modfile. All problems of the modfile are reported at once, the returned error joins
+// one error per problem (see errors.Join) sorted by position. Previous versions returned only the first problem.
+// ErrEmpty is returned if b does not contain a modfile.
+func Unmarshal(b []byte, opts ...Option) (module_lib.Module, error) {
+	return decodeOne(NewDecoder(bytes.NewReader(b), opts...))
+}
+
+// Decode is like Unmarshal but reads the modfile from r.
+func Decode(r io.Reader, opts ...Option) (module_lib.Module, error) {
+	return decodeOne(NewDecoder(r, opts...))
+}
+
+func decodeOne(d *Decoder) (module_lib.Module, error) {
+	mod, err := d.Decode()
+	if errors.Is(err, io.EOF) {
+		return module_lib.Module{}, ErrEmpty
+	}
+	return mod, err
+}
+
+// DecodeAll reads all modfiles from a multi-document stream. Documents that can't be
+// decoded are reported as *DocumentError and don't stop decoding, the returned slice
+// holds a zero Module in their place. An error of the underlying stream ends decoding.
+func DecodeAll(r io.Reader, opts ...Option) ([]module_lib.Module, error) {
+	d := NewDecoder(r, opts...)
+	var mods []module_lib.Module
+	var errs []error
+	for {
+		mod, err := d.Decode()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			errs = append(errs, &DocumentError{Index: len(mods), Err: err})
+			if d.err != nil {
+				break
+			}
+		}
+		mods = append(mods, mod)
+	}
+	return mods, errors.Join(errs...)
+}
+
+// DecodeJSON reads a JSON modfile from r. JSON modfiles are decoded with the same semantics as YAML modfiles, the
+// model is decoded with encoding/json for the built-in modfile versions.
+func DecodeJSON(r io.Reader, opts ...Option) (module_lib.Module, error) {
+	opt := newOptions(opts)
+	b, err := io.ReadAll(&sizeReader{r: r, max: opt.maxSize})
 	if err != nil {
+		if errors.Is(err, ErrMaxSize) {
+			return module_lib.Module{}, sizeError(opt.maxSize)
+		}
 		return module_lib.Module{}, err
 	}
-	return getModule(nw.Version, nw.Node)
+	var v any
+	if err = json.Unmarshal(b, &v); err != nil {
+		return module_lib.Module{}, err
+	}
+	if _, ok := v.(map[string]any); !ok {
+		return module_lib.Module{}, errors.New("invalid modfile: object required")
+	}
+	d := NewDecoder(bytes.NewReader(b), opts...)
+	d.isJSON = true
+	return decodeOne(d)
 }
 
-func Decode(r io.Reader) (module_lib.Module, error) {
-	var nw nodeWrapper
-	err := yaml.NewDecoder(r).Decode(&nw)
+func getModule(version string, yn *yaml.Node, opt options, isJSON bool) (module_lib.Module, error) {
+	if len(opt.versions) > 0 && !slices.Contains(opt.versions, version) {
+		return module_lib.Module{}, errors.New("modfile version not allowed: " + version)
+	}
+	h, ok := getHandler(version)
+	if !ok {
+		return module_lib.Module{}, errors.New("unknown modfile version: " + version)
+	}
+	if jh, ok := jsonHandlers[version]; ok && isJSON {
+		h = jh
+	}
+	genOpt := opt.generatorOptions()
+	inc, err := v1_generator.Prepare(yn, genOpt)
 	if err != nil {
 		return module_lib.Module{}, err
 	}
-	return getModule(nw.Version, nw.Node)
+	return h(yn, inc, genOpt)
 }
 
-func getModule(version string, yn *yaml.Node) (module_lib.Module, error) {
-	switch version {
-	case v1_model.Version:
-		return v1_generator.GetModule(yn)
-	default:
-		return module_lib.Module{}, errors.New("unknown modfile version: " + version)
+func sizeError(maxSize int64) error {
+	return &LimitError{Limit: v1_model.LimitSize, Max: maxSize, Err: ErrMaxSize}
+}
+
+// sizeReader fails once more than max bytes have been read, a max of zero disables the limit. The Decoder resets
+// the count for each document, data read ahead by the YAML decoder counts towards the current document.
+type sizeReader struct {
+	r        io.Reader
+	max      int64
+	n        int64
+	exceeded bool
+}
+
+func (r *sizeReader) Read(p []byte) (int, error) {
+	if r.max <= 0 {
+		return r.r.Read(p)
+	}
+	if r.n > r.max {
+		r.exceeded = true
+		return 0, ErrMaxSize
+	}
+	if l := r.max - r.n + 1; int64(len(p)) > l {
+		p = p[:l]
 	}
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
 }
 
 type modfileBase struct {
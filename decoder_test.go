@@ -0,0 +1,763 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile_lib
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/imageref"
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v2/migration"
+	"gopkg.in/yaml.v3"
+)
+
+const testModFile = `modfileVersion: v1
+id: github.com/user/repo
+name: Test
+description: test module
+tags:
+  - tag
+license: Apache License 2.0
+author: author
+version: v1.0.0
+architectures:
+  - amd64
+  - arm64v8
+services:
+  api:
+    name: API
+    image: ghcr.io/user/api:v1.0.0
+    runConfig:
+      stopTimeout: 10s
+      command: run --debug
+    include:
+      - mountPoint: /opt/static
+        source: static
+        readOnly: true
+    tmpfs:
+      - mountPoint: /tmp
+        size: 64Mb
+        mode: "0777"
+    httpEndpoints:
+      - name: API
+        port: 8080
+        path: /api
+        extPath: api
+        proxyConf:
+          readTimeout: 30s
+    ports:
+      - port: 8080-8081
+        hostPort: 9080-9081
+      - name: dns
+        port: 53
+        hostPort: 5353-5355
+        protocol: udp
+  db:
+    name: DB
+    image: ghcr.io/user/db:v1.0.0
+auxServices:
+  job:
+    name: Job
+    runConfig:
+      command:
+        - run
+        - --once
+auxImageSources:
+  - ghcr.io/user/*
+serviceReferences:
+  db:
+    - refVar: DB_HOST
+      services:
+        - api
+      auxServices:
+        - job
+volumes:
+  data:
+    - mountPoint: /data
+      services:
+        - db
+      auxServices:
+        - job
+  cache: []
+dependencies:
+  github.com/user/broker:
+    version: ">=v1.0.0;<v2.0.0"
+    requiredServices:
+      broker:
+        - refVar: BROKER_URL
+          template: tcp://{ref}:1883
+          services:
+            - api
+hostResources:
+  serial:
+    tags:
+      - tty
+    userInput:
+      name: Serial
+    targets:
+      - mountPoint: /dev/ttyUSB0
+        services:
+          - api
+secrets:
+  cert:
+    type: certificate
+    optional: true
+    targets:
+      - mountPoint: /certs
+        item: crt
+        services:
+          - api
+      - refVar: CERT_KEY
+        item: key
+        services:
+          - api
+configs:
+  level:
+    value: info
+    options:
+      - debug
+      - info
+    userInput:
+      name: Log level
+      type: text
+      group: general
+    targets:
+      - refVar: LOG_LEVEL
+        services:
+          - api
+          - db
+        auxServices:
+          - job
+  threshold:
+    value: 1.0
+    dataType: float
+    userInput:
+      name: Threshold
+      type: number
+      typeOptions:
+        min: 0.0
+        max: 2.5
+    targets:
+      - refVar: THRESHOLD
+        services:
+          - api
+  ids:
+    value:
+      - 1
+      - 2
+    dataType: int
+    isList: true
+    delimiter: ;
+    optional: true
+    targets:
+      - refVar: IDS
+        services:
+          - api
+  debug:
+    value: false
+    dataType: bool
+files:
+  settings:
+    source: settings.json
+    userInput:
+      name: Settings
+      type: json
+    targets:
+      - mountPoint: /etc/settings.json
+        services:
+          - api
+fileGroups:
+  plugins:
+    userInput:
+      name: Plugins
+    targets:
+      - basePath: /opt/plugins
+        services:
+          - api
+inputGroups:
+  general:
+    name: General
+`
+
+func TestMarshal(t *testing.T) {
+	a, err := Unmarshal([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bytes, err := Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Unmarshal(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+}
+
+const testErrModFile = `modfileVersion: v1
+id: github.com/user/test
+name: Test
+version: v1.0.0
+services:
+  api:
+    name: API
+    image: ghcr.io/user/api:v1.0.0
+    ports:
+      - port: 80
+      - port: 81-83
+        hostPort: 8080-8081
+volumes:
+  data:
+    - mountPoint: /data
+      services:
+        - api
+        - web
+`
+
+func TestUnmarshalErrors(t *testing.T) {
+	_, err := Unmarshal([]byte(testErrModFile))
+	if err == nil {
+		t.Fatal("err == nil")
+	}
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("not joined")
+	}
+	errs := je.Unwrap()
+	a := []struct {
+		path   string
+		line   int
+		column int
+	}{
+		{"services.api.ports[1]", 11, 9},
+		{"volumes.data[0].services[1]", 18, 11},
+	}
+	if len(errs) != len(a) {
+		t.Fatalf("%d != %d", len(errs), len(a))
+	}
+	for i, b := range a {
+		var e *v1_model.Error
+		if !errors.As(errs[i], &e) {
+			t.Errorf("%d: not *Error", i)
+			continue
+		}
+		if e.Path.String() != b.path || e.Line != b.line || e.Column != b.column {
+			t.Errorf("%d: %s %d %d", i, e.Path, e.Line, e.Column)
+		}
+	}
+	// ---------------------------
+	_, err = Unmarshal([]byte("modfileVersion: v1\nservices:\n  api:\n    ports:\n      - port: 1-x\n"))
+	var e *v1_model.Error
+	if !errors.As(err, &e) {
+		t.Fatal("not *Error")
+	}
+	if e.Path.String() != "services.api.ports[0].port" || e.Line != 5 || e.Column != 15 {
+		t.Errorf("%s %d %d", e.Path, e.Line, e.Column)
+	}
+}
+
+func TestUnmarshalOptions(t *testing.T) {
+	if _, err := Unmarshal([]byte(testModFile), WithStrict()); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	b := []byte(testModFile + "unknown: test\n")
+	if _, err := Unmarshal(b); err != nil {
+		t.Error(err)
+	}
+	_, err := Unmarshal(b, WithStrict())
+	var e *v1_model.Error
+	var ufErr *v1_model.UnknownFieldError
+	if !errors.As(err, &e) || !errors.As(err, &ufErr) {
+		t.Fatal("not *Error / *UnknownFieldError")
+	}
+	if e.Path.String() != "unknown" || ufErr.Field != "unknown" {
+		t.Errorf("%s %s", e.Path, ufErr.Field)
+	}
+	// ---------------------------
+	var warnings []error
+	if _, err = Unmarshal(b, WithWarnings(func(err error) { warnings = append(warnings, err) })); err != nil {
+		t.Error(err)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("%d != 1", len(warnings))
+	} else if !errors.As(warnings[0], &ufErr) {
+		t.Error("not *UnknownFieldError")
+	}
+	// ---------------------------
+	if _, err = Unmarshal([]byte(testModFile), WithMaxSize(int64(len(testModFile)))); err != nil {
+		t.Error(err)
+	}
+	if _, err = Unmarshal([]byte(testModFile), WithMaxSize(64)); !errors.Is(err, ErrMaxSize) {
+		t.Error("not ErrMaxSize")
+	}
+	// ---------------------------
+	for _, in := range []string{"", "\n", "---\n"} {
+		if _, err = Unmarshal([]byte(in)); !errors.Is(err, ErrEmpty) {
+			t.Errorf("%q: %v != %v", in, err, ErrEmpty)
+		}
+	}
+	var lErr *LimitError
+	if _, err = Unmarshal([]byte(testModFile), WithMaxSize(64)); !errors.As(err, &lErr) {
+		t.Error("not *LimitError")
+	} else if lErr.Limit != v1_model.LimitSize {
+		t.Errorf("%s != %s", lErr.Limit, v1_model.LimitSize)
+	}
+	// ---------------------------
+	for name, opt := range map[string]Option{
+		v1_model.LimitNodes: WithMaxNodes(100),
+		v1_model.LimitDepth: WithMaxDepth(3),
+		v1_model.LimitPorts: WithMaxPorts(1),
+	} {
+		if _, err = Unmarshal([]byte(testModFile), opt); !errors.As(err, &lErr) {
+			t.Errorf("%s: not *LimitError", name)
+		} else if lErr.Limit != name {
+			t.Errorf("%s != %s", lErr.Limit, name)
+		}
+	}
+	if _, err = Unmarshal([]byte(testModFile), WithMaxNodes(10000), WithMaxDepth(100), WithMaxPorts(100)); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	if _, err = Unmarshal([]byte(testModFile), WithVersions(v1_model.Version)); err != nil {
+		t.Error(err)
+	}
+	if _, err = Unmarshal([]byte(testModFile), WithVersions("v2")); err == nil {
+		t.Error("err == nil")
+	}
+	// ---------------------------
+	b = []byte(strings.Replace(testModFile, "image: ghcr.io/user/api:v1.0.0", "image: ghcr.io/user/api:latest", 1))
+	if _, err = Unmarshal(b); !errors.Is(err, imageref.ErrMutableTag) {
+		t.Error("not ErrMutableTag")
+	} else if !errors.As(err, &e) || e.Path.String() != "services.api.image" {
+		t.Error(err)
+	}
+	if _, err = Unmarshal(b, WithMutableTags()); err != nil {
+		t.Error(err)
+	}
+	if _, err = Unmarshal([]byte(testModFile), WithMutableTags("v1.0.0")); !errors.Is(err, imageref.ErrMutableTag) {
+		t.Error("not ErrMutableTag")
+	}
+	b = []byte(strings.Replace(testModFile, "image: ghcr.io/user/api:v1.0.0", "image: ghcr.io/user/api", 1))
+	if _, err = Unmarshal(b, WithMutableTags()); !errors.Is(err, imageref.ErrUntagged) {
+		t.Error("not ErrUntagged")
+	}
+}
+
+func TestUnmarshalIncludes(t *testing.T) {
+	a, err := Unmarshal([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var yn yaml.Node
+	if err = yaml.Unmarshal([]byte(testModFile), &yn); err != nil {
+		t.Fatal(err)
+	}
+	configs := v1_model.Path{"configs"}.Node(&yn)
+	cBytes, err := yaml.Marshal(configs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	*configs = yaml.Node{Kind: yaml.ScalarNode, Tag: v1_model.IncludeTag, Value: "configs/configs.yml"}
+	b, err := yaml.Marshal(&yn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"configs/configs.yml": {Data: cBytes},
+		"settings.json":       {Data: []byte("{}")},
+	}
+	c, err := Unmarshal(b, WithStrict(), WithRepoFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, c) == false {
+		t.Errorf("%+v != %+v", a, c)
+	}
+	// ---------------------------
+	if _, err = Unmarshal(b); err == nil {
+		t.Error("err == nil")
+	}
+	// ---------------------------
+	fsys["configs/configs.yml"] = &fstest.MapFile{Data: append(cBytes, "unknown: test\n"...)}
+	_, err = Unmarshal(b, WithStrict(), WithRepoFS(fsys))
+	var e *v1_model.Error
+	if !errors.As(err, &e) {
+		t.Fatal("not *Error")
+	}
+	if e.File != "configs/configs.yml" {
+		t.Errorf("%s != configs/configs.yml", e.File)
+	}
+	// ---------------------------
+	fsys["configs/configs.yml"] = &fstest.MapFile{Data: append(cBytes, "# "+strings.Repeat("x", len(b))+"\n"...)}
+	var lErr *LimitError
+	for name, opt := range map[string]Option{
+		v1_model.LimitSize:        WithMaxSize(int64(len(b))),
+		v1_model.LimitIncludeSize: WithMaxIncludeSize(int64(len(cBytes))),
+	} {
+		if _, err = Unmarshal(b, opt, WithRepoFS(fsys)); !errors.As(err, &lErr) {
+			t.Errorf("%s: not *LimitError", name)
+		} else if lErr.Limit != name {
+			t.Errorf("%s != %s", lErr.Limit, name)
+		}
+	}
+}
+
+func TestUnmarshalVariables(t *testing.T) {
+	a, err := Unmarshal([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := []byte(strings.Replace(strings.Replace(testModFile, "version: v1.0.0", "version: ${VERSION}", 1), "image: ghcr.io/user/api:v1.0.0", "image: ${REGISTRY:-docker.io}/user/api:${VERSION}", 1))
+	c, err := Unmarshal(b, WithVariables(map[string]string{"VERSION": "v1.0.0", "REGISTRY": "ghcr.io"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, c) == false {
+		t.Errorf("%+v != %+v", a, c)
+	}
+	// ---------------------------
+	if _, err = Unmarshal(b); err == nil {
+		t.Error("err == nil")
+	}
+	// ---------------------------
+	_, err = Unmarshal(b, WithVariables(nil))
+	var uvErr *v1_model.UndefinedVariableError
+	if !errors.As(err, &uvErr) {
+		t.Fatal("not *UndefinedVariableError")
+	}
+	if uvErr.Name != "VERSION" {
+		t.Errorf("%s != VERSION", uvErr.Name)
+	}
+}
+
+func TestUnmarshalIDs(t *testing.T) {
+	a, err := Unmarshal([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := strings.Replace(testModFile, "id: github.com/user/repo", "id: https://GitHub.com/user/repo/", 1)
+	doc = strings.Replace(doc, "  github.com/user/broker:", "  GitHub.com/user/broker/:", 1)
+	b, err := Unmarshal([]byte(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+	// ---------------------------
+	bytes, err := migration.MigrateYAML([]byte(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := Unmarshal(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, c) == false {
+		t.Errorf("%+v != %+v", a, c)
+	}
+	// ---------------------------
+	_, err = Unmarshal([]byte(strings.Replace(testModFile, "id: github.com/user/repo", "id: user/repo", 1)))
+	var e *v1_model.Error
+	if !errors.As(err, &e) {
+		t.Fatal("not *Error")
+	}
+	if e.Path.String() != "id" || e.Line != 2 {
+		t.Errorf("%s %d", e.Path, e.Line)
+	}
+	// ---------------------------
+	doc = strings.Replace(testModFile, "  github.com/user/broker:", "  https://github.com/user/broker:", 1)
+	_, err = Unmarshal([]byte(strings.Replace(doc, `version: ">=v1.0.0;<v2.0.0"`, "version: bogus", 1)))
+	if !errors.As(err, &e) {
+		t.Fatal("not *Error")
+	}
+	if e.Path.String() != `dependencies["https://github.com/user/broker"].version` || e.Line != 71 {
+		t.Errorf("%s %d", e.Path, e.Line)
+	}
+	// ---------------------------
+	_, err = Unmarshal([]byte(strings.Replace(doc, "            - api", "            - unknown", 1)))
+	if !errors.As(err, &e) {
+		t.Fatal("not *Error")
+	}
+	if e.Path.String() != `dependencies["https://github.com/user/broker"].requiredServices.broker[0].services[0]` || e.Line != 77 {
+		t.Errorf("%s %d", e.Path, e.Line)
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	a, err := Unmarshal([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewDecoder(strings.NewReader(testModFile), WithStrict()).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+}
+
+func TestDecodeAll(t *testing.T) {
+	a, err := Unmarshal([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("valid", func(t *testing.T) {
+		mods, err := DecodeAll(strings.NewReader(testModFile + "\n---\n" + testModFile + "\n---\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(mods) != 2 {
+			t.Fatalf("%d != 2", len(mods))
+		}
+		for _, b := range mods {
+			if reflect.DeepEqual(a, b) == false {
+				t.Errorf("%+v != %+v", a, b)
+			}
+		}
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		mods, err := DecodeAll(strings.NewReader(testModFile + "\n---\nmodfileVersion: v0\n---\n" + testModFile))
+		if err == nil {
+			t.Fatal("err == nil")
+		}
+		var dErr *DocumentError
+		if !errors.As(err, &dErr) {
+			t.Fatal("expected DocumentError")
+		}
+		if dErr.Index != 1 {
+			t.Errorf("%d != 1", dErr.Index)
+		}
+		if len(mods) != 3 {
+			t.Fatalf("%d != 3", len(mods))
+		}
+		if reflect.DeepEqual(a, mods[2]) == false {
+			t.Errorf("%+v != %+v", a, mods[2])
+		}
+	})
+	t.Run("invalid stream", func(t *testing.T) {
+		mods, err := DecodeAll(strings.NewReader(testModFile + "\n---\nname: [\n---\n" + testModFile))
+		if err == nil {
+			t.Fatal("err == nil")
+		}
+		var dErr *DocumentError
+		if !errors.As(err, &dErr) {
+			t.Fatal("expected DocumentError")
+		}
+		if dErr.Index != 1 {
+			t.Errorf("%d != 1", dErr.Index)
+		}
+		if len(mods) != 1 {
+			t.Errorf("%d != 1", len(mods))
+		}
+	})
+	t.Run("max size", func(t *testing.T) {
+		stream := strings.Repeat(testModFile+"\n---\n", 3)
+		mods, err := DecodeAll(strings.NewReader(stream), WithMaxSize(int64(len(testModFile)+1024)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(mods) != 3 {
+			t.Errorf("%d != 3", len(mods))
+		}
+		if _, err = DecodeAll(strings.NewReader(stream), WithMaxSize(64)); !errors.Is(err, ErrMaxSize) {
+			t.Error("not ErrMaxSize")
+		}
+	})
+	t.Run("decoder", func(t *testing.T) {
+		d := NewDecoder(strings.NewReader(testModFile))
+		if _, err := d.Decode(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := d.Decode(); !errors.Is(err, io.EOF) {
+			t.Errorf("%v != %v", err, io.EOF)
+		}
+	})
+}
+
+func TestUnmarshalV2(t *testing.T) {
+	a, err := Unmarshal([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mf v1_model.ModFile
+	if err = yaml.Unmarshal([]byte(testModFile), &mf); err != nil {
+		t.Fatal(err)
+	}
+	v2MF, err := migration.Migrate(mf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bytes, err := yaml.Marshal(v2MF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Unmarshal(bytes, WithStrict())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+	// ---------------------------
+	bytes, err = migration.MigrateYAML([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := Unmarshal(bytes, WithStrict())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, c) == false {
+		t.Errorf("%+v != %+v", a, c)
+	}
+}
+
+const testJSONModFile = `{
+	"modfileVersion": "v1",
+	"id": "github.com/user/test",
+	"name": "Test",
+	"version": "v1.0.0",
+	"services": {
+		"api": {
+			"name": "API",
+			"image": "ghcr.io/user/api:v1.0.0",
+			"runConfig": {
+				"stopTimeout": "10s",
+				"command": "run"
+			},
+			"tmpfs": [
+				{"mountPoint": "/tmp", "size": "64Mb", "mode": "0770"},
+				{"mountPoint": "/cache", "size": 1024, "mode": 700}
+			],
+			"ports": [
+				{"port": 80, "hostPort": "8080"},
+				{"port": "90-91", "hostPort": "9090-9091", "protocol": "udp"}
+			]
+		}
+	},
+	"configs": {
+		"level": {
+			"value": 2,
+			"dataType": "int",
+			"options": [1, 2, 3],
+			"targets": [{"refVar": "LEVEL", "services": ["api"]}]
+		},
+		"threshold": {
+			"value": 1.5,
+			"dataType": "float",
+			"userInput": {"name": "Threshold", "type": "number", "typeOptions": {"min": 0, "step": 0.5}},
+			"targets": [{"refVar": "THRESHOLD", "services": ["api"]}]
+		}
+	}
+}`
+
+const testJSONModFileYAML = `modfileVersion: v1
+id: github.com/user/test
+name: Test
+version: v1.0.0
+services:
+  api:
+    name: API
+    image: ghcr.io/user/api:v1.0.0
+    runConfig:
+      stopTimeout: 10s
+      command: run
+    tmpfs:
+      - mountPoint: /tmp
+        size: 64Mb
+        mode: "0770"
+      - mountPoint: /cache
+        size: 1024
+        mode: 700
+    ports:
+      - port: 80
+        hostPort: "8080"
+      - port: 90-91
+        hostPort: 9090-9091
+        protocol: udp
+configs:
+  level:
+    value: 2
+    dataType: int
+    options: [1, 2, 3]
+    targets:
+      - refVar: LEVEL
+        services: [api]
+  threshold:
+    value: 1.5
+    dataType: float
+    userInput:
+      name: Threshold
+      type: number
+      typeOptions:
+        min: 0
+        step: 0.5
+    targets:
+      - refVar: THRESHOLD
+        services: [api]
+`
+
+func TestDecodeJSON(t *testing.T) {
+	a, err := Unmarshal([]byte(testJSONModFileYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := DecodeJSON(strings.NewReader(testJSONModFile), WithStrict())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+	// ---------------------------
+	var mf v1_model.ModFile
+	if err = json.Unmarshal([]byte(testJSONModFile), &mf); err != nil {
+		t.Fatal(err)
+	}
+	var mf2 v1_model.ModFile
+	if err = yaml.Unmarshal([]byte(testJSONModFileYAML), &mf2); err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(mf, mf2) == false {
+		t.Errorf("%+v != %+v", mf, mf2)
+	}
+	// ---------------------------
+	if _, err = DecodeJSON(strings.NewReader(testJSONModFile[:100])); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err = DecodeJSON(strings.NewReader("[]")); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err = DecodeJSON(strings.NewReader(strings.Replace(testJSONModFile, `"64Mb"`, `"64Xb"`, 1))); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err = DecodeJSON(strings.NewReader(testJSONModFile), WithMaxSize(100)); !errors.Is(err, ErrMaxSize) {
+		t.Error("not ErrMaxSize")
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile_lib
+
+import (
+	"bytes"
+
+	v1_generator "github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Marshal returns the module as a v1 modfile. Decoding the result yields an equal module.
+func Marshal(mod module_lib.Module) ([]byte, error) {
+	mf, err := v1_generator.GetModFile(mod)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(mf); err != nil {
+		return nil, err
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
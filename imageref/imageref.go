@@ -0,0 +1,138 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageref
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	DefaultRegistry = "docker.io"
+	officialRepo    = "library"
+	maxNameLength   = 255
+)
+
+var (
+	ErrUntagged   = errors.New("must be versioned via tag or digest")
+	ErrMutableTag = errors.New("mutable tag")
+)
+
+// DefaultMutableTags lists the tags rejected by default by the modfile decoders.
+var DefaultMutableTags = []string{"latest"}
+
+var (
+	domainRe    = regexp.MustCompile(`^(?:localhost|(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*|\[[a-fA-F0-9:]+\])(?::[0-9]+)?$`)
+	componentRe = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
+	tagRe       = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	digestRe    = regexp.MustCompile(`^[a-z0-9]+(?:[.+_-][a-z0-9]+)*:[a-fA-F0-9]{32,}$`)
+)
+
+// Reference is a parsed container image reference, e.g. ghcr.io/user/api:v1.0.0.
+type Reference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// Parse parses an image reference in the form [registry/]repository[:tag][@digest]. References without a
+// registry are normalized to Docker Hub, including the 'library' namespace of official images (e.g. nginx).
+func Parse(s string) (Reference, error) {
+	if s == "" {
+		return Reference{}, errors.New("invalid image reference: empty")
+	}
+	var ref Reference
+	name := s
+	if i := strings.Index(name, "@"); i >= 0 {
+		ref.Digest = name[i+1:]
+		name = name[:i]
+		if !digestRe.MatchString(ref.Digest) || (strings.HasPrefix(ref.Digest, "sha256:") && len(ref.Digest) != 71) {
+			return Reference{}, fmt.Errorf("invalid image reference '%s': invalid digest '%s'", s, ref.Digest)
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		ref.Tag = name[i+1:]
+		name = name[:i]
+		if !tagRe.MatchString(ref.Tag) {
+			return Reference{}, fmt.Errorf("invalid image reference '%s': invalid tag '%s'", s, ref.Tag)
+		}
+	}
+	if len(name) > maxNameLength {
+		return Reference{}, fmt.Errorf("invalid image reference '%s': name exceeds %d characters", s, maxNameLength)
+	}
+	repo := name
+	if i := strings.Index(name, "/"); i >= 0 {
+		if domain := name[:i]; domain == "localhost" || strings.ContainsAny(domain, ".:") {
+			if !domainRe.MatchString(domain) {
+				return Reference{}, fmt.Errorf("invalid image reference '%s': invalid registry '%s'", s, domain)
+			}
+			ref.Registry = domain
+			repo = name[i+1:]
+		}
+	}
+	for _, component := range strings.Split(repo, "/") {
+		if !componentRe.MatchString(component) {
+			return Reference{}, fmt.Errorf("invalid image reference '%s': invalid repository '%s'", s, repo)
+		}
+	}
+	if ref.Registry == "" || ref.Registry == "index.docker.io" {
+		ref.Registry = DefaultRegistry
+	}
+	if ref.Registry == DefaultRegistry && !strings.Contains(repo, "/") {
+		repo = officialRepo + "/" + repo
+	}
+	ref.Repository = repo
+	return ref, nil
+}
+
+// Name returns the fully qualified repository name, e.g. docker.io/library/nginx.
+func (r Reference) Name() string {
+	return r.Registry + "/" + r.Repository
+}
+
+func (r Reference) String() string {
+	s := r.Name()
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// Check parses an image reference and verifies that it is versioned via tag or digest. Tags contained
+// in mutableTags are rejected unless the reference is pinned by digest.
+func Check(s string, mutableTags []string) (Reference, error) {
+	ref, err := Parse(s)
+	if err != nil {
+		return Reference{}, err
+	}
+	if ref.Digest == "" {
+		if ref.Tag == "" {
+			return Reference{}, fmt.Errorf("image '%s' %w", s, ErrUntagged)
+		}
+		if slices.Contains(mutableTags, ref.Tag) {
+			return Reference{}, fmt.Errorf("image '%s' uses %w '%s', use a fixed tag or digest", s, ErrMutableTag, ref.Tag)
+		}
+	}
+	return ref, nil
+}
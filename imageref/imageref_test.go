@@ -0,0 +1,78 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageref
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	tests := []struct {
+		s string
+		a Reference
+	}{
+		{"nginx:1.25", Reference{Registry: DefaultRegistry, Repository: "library/nginx", Tag: "1.25"}},
+		{"user/api:v1.0.0", Reference{Registry: DefaultRegistry, Repository: "user/api", Tag: "v1.0.0"}},
+		{"index.docker.io/user/api", Reference{Registry: DefaultRegistry, Repository: "user/api"}},
+		{"ghcr.io/user/api:v1.0.0", Reference{Registry: "ghcr.io", Repository: "user/api", Tag: "v1.0.0"}},
+		{"localhost:5000/api:v1", Reference{Registry: "localhost:5000", Repository: "api", Tag: "v1"}},
+		{"localhost/a/b_c/d-e:v1", Reference{Registry: "localhost", Repository: "a/b_c/d-e", Tag: "v1"}},
+		{"ghcr.io/user/api@" + digest, Reference{Registry: "ghcr.io", Repository: "user/api", Digest: digest}},
+		{"nginx:latest@" + digest, Reference{Registry: DefaultRegistry, Repository: "library/nginx", Tag: "latest", Digest: digest}},
+	}
+	for _, tc := range tests {
+		ref, err := Parse(tc.s)
+		if err != nil {
+			t.Errorf("%s: %s", tc.s, err)
+		} else if ref != tc.a {
+			t.Errorf("%s: %+v != %+v", tc.s, ref, tc.a)
+		}
+	}
+	invalid := []string{"", "Nginx:v1", "nginx:", "nginx:-v1", "nginx@sha256:abc", "ghcr.io//api:v1", "-a.io/api:v1", "api:v1/x"}
+	for _, s := range invalid {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("%s: err == nil", s)
+		}
+	}
+	if s := (Reference{Registry: "ghcr.io", Repository: "user/api", Tag: "v1", Digest: digest}).String(); s != "ghcr.io/user/api:v1@"+digest {
+		t.Error(s)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	if _, err := Check("nginx:1.25", []string{"latest"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := Check("nginx", nil); !errors.Is(err, ErrUntagged) {
+		t.Error("not ErrUntagged")
+	}
+	if _, err := Check("nginx:latest", nil); err != nil {
+		t.Error(err)
+	}
+	if _, err := Check("nginx:latest", []string{"latest"}); !errors.Is(err, ErrMutableTag) {
+		t.Error("not ErrMutableTag")
+	}
+	if _, err := Check("nginx@"+digest, []string{"latest"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := Check("nginx:latest@"+digest, []string{"latest"}); err != nil {
+		t.Error(err)
+	}
+}
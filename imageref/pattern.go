@@ -0,0 +1,163 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageref
+
+import (
+	"fmt"
+	"strings"
+
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+// Pattern matches image references. Patterns use the reference syntax [registry/]repository[:tag][@digest]
+// with the following glob semantics:
+//   - '*' matches any sequence of characters within a single registry, path segment or tag
+//   - '**' as a whole path segment matches zero or more path segments
+//   - without tag and digest all tags and digests of matching repositories are accepted
+//   - a tag restricts images to matching tags, images pinned by digest only are rejected
+//   - a digest restricts images to that exact digest
+//
+// Patterns are normalized like references, e.g. 'senergy/*' matches 'docker.io/senergy/api:v1'.
+type Pattern struct {
+	registry string
+	path     []string
+	tag      string
+	digest   string
+}
+
+// ParsePattern parses and validates an image pattern, e.g. ghcr.io/senergy-platform/*.
+func ParsePattern(s string) (Pattern, error) {
+	// validate the pattern as reference by substituting wildcards
+	if _, err := Parse(strings.ReplaceAll(s, "*", "x")); err != nil {
+		return Pattern{}, fmt.Errorf("invalid image pattern '%s': %w", s, err)
+	}
+	var p Pattern
+	name := s
+	if i := strings.Index(name, "@"); i >= 0 {
+		p.digest = name[i+1:]
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		p.tag = name[i+1:]
+		name = name[:i]
+	}
+	segments := strings.Split(name, "/")
+	if first := segments[0]; len(segments) > 1 && (first == "localhost" || strings.ContainsAny(first, ".:")) {
+		p.registry = first
+		segments = segments[1:]
+	}
+	for _, segment := range segments {
+		if strings.Contains(segment, "**") && segment != "**" {
+			return Pattern{}, fmt.Errorf("invalid image pattern '%s': '**' must be a whole path segment", s)
+		}
+	}
+	if p.registry == "" || p.registry == "index.docker.io" {
+		p.registry = DefaultRegistry
+	}
+	if p.registry == DefaultRegistry && len(segments) == 1 && segments[0] != "**" {
+		segments = append([]string{officialRepo}, segments...)
+	}
+	p.path = segments
+	return p, nil
+}
+
+func (p Pattern) String() string {
+	s := p.registry + "/" + strings.Join(p.path, "/")
+	if p.tag != "" {
+		s += ":" + p.tag
+	}
+	if p.digest != "" {
+		s += "@" + p.digest
+	}
+	return s
+}
+
+// Match reports if the reference matches the pattern.
+func (p Pattern) Match(ref Reference) bool {
+	if !matchGlob(p.registry, ref.Registry) || !matchPath(p.path, strings.Split(ref.Repository, "/")) {
+		return false
+	}
+	if p.digest != "" && p.digest != ref.Digest {
+		return false
+	}
+	if p.tag != "" && (ref.Tag == "" || !matchGlob(p.tag, ref.Tag)) {
+		return false
+	}
+	return true
+}
+
+// AuxImageAllowed reports if the image matches one of the aux image sources of the module, see Pattern.
+// Invalid images and patterns never match.
+func AuxImageAllowed(mod module_lib.Module, image string) bool {
+	ref, err := Parse(image)
+	if err != nil {
+		return false
+	}
+	for src := range mod.AuxImgSrc {
+		if p, err := ParsePattern(src); err == nil && p.Match(ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceImages returns the parsed images of all services of the module. The images of generated modules are
+// valid, invalid images are omitted.
+func ServiceImages(mod module_lib.Module) map[string]Reference {
+	refs := make(map[string]Reference)
+	for srvRef, srv := range mod.Services {
+		if ref, err := Parse(srv.Image); err == nil {
+			refs[srvRef] = ref
+		}
+	}
+	return refs
+}
+
+func matchPath(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchPath(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	return len(segments) > 0 && matchGlob(pattern[0], segments[0]) && matchPath(pattern[1:], segments[1:])
+}
+
+// matchGlob matches s against a pattern where '*' matches any sequence of characters.
+func matchGlob(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageref
+
+import (
+	"testing"
+
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func TestParsePattern(t *testing.T) {
+	valid := map[string]string{
+		"ghcr.io/senergy-platform/*":   "ghcr.io/senergy-platform/*",
+		"senergy/*":                    "docker.io/senergy/*",
+		"nginx":                        "docker.io/library/nginx",
+		"index.docker.io/user/api:v1*": "docker.io/user/api:v1*",
+		"localhost:5000/**":            "localhost:5000/**",
+		"*.example.com/a/**/b:*":       "*.example.com/a/**/b:*",
+	}
+	for s, a := range valid {
+		p, err := ParsePattern(s)
+		if err != nil {
+			t.Errorf("%s: %s", s, err)
+		} else if p.String() != a {
+			t.Errorf("%s: %s != %s", s, p, a)
+		}
+	}
+	invalid := []string{"", "ghcr.io/Senergy/*", "ghcr.io/a**/b", "ghcr.io//*", "nginx@sha256:*", "nginx:"}
+	for _, s := range invalid {
+		if _, err := ParsePattern(s); err == nil {
+			t.Errorf("%s: err == nil", s)
+		}
+	}
+}
+
+func TestAuxImageAllowed(t *testing.T) {
+	digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	mod := module_lib.Module{
+		AuxImgSrc: map[string]struct{}{
+			"ghcr.io/senergy-platform/*":     {},
+			"ghcr.io/user/**/worker":         {},
+			"senergy/api:v1.*":               {},
+			"nginx@" + digest:                {},
+			"registry.*.example.com/tools/*": {},
+			"ghcr.io/Invalid/*":              {},
+		},
+	}
+	allowed := []string{
+		"ghcr.io/senergy-platform/api:v1.0.0",
+		"ghcr.io/senergy-platform/api@" + digest,
+		"ghcr.io/user/worker:v1",
+		"ghcr.io/user/a/b/worker:v1",
+		"senergy/api:v1.2.3",
+		"docker.io/senergy/api:v1.0",
+		"nginx@" + digest,
+		"docker.io/library/nginx:1.25@" + digest,
+		"registry.eu.example.com/tools/cli:v1",
+	}
+	for _, image := range allowed {
+		if !AuxImageAllowed(mod, image) {
+			t.Errorf("%s not allowed", image)
+		}
+	}
+	denied := []string{
+		"ghcr.io/senergy-platform/a/b:v1",
+		"ghcr.io/senergy-platform-x/api:v1",
+		"docker.io/senergy-platform/api:v1",
+		"ghcr.io/user/worker2:v1",
+		"senergy/api:v2.0.0",
+		"senergy/api@" + digest,
+		"nginx:1.25",
+		"registry.example.com/tools/cli:v1",
+		"ghcr.io/invalid/api:v1",
+		"Invalid",
+	}
+	for _, image := range denied {
+		if AuxImageAllowed(mod, image) {
+			t.Errorf("%s allowed", image)
+		}
+	}
+	if AuxImageAllowed(module_lib.Module{}, "nginx:1.25") {
+		t.Error("allowed without sources")
+	}
+}
+
+func TestServiceImages(t *testing.T) {
+	mod := module_lib.Module{
+		Services: map[string]module_lib.Service{
+			"a": {Image: "nginx:1.25"},
+			"b": {Image: "ghcr.io/user/api:v1.0.0"},
+			"c": {Image: "Invalid"},
+		},
+	}
+	refs := ServiceImages(mod)
+	if len(refs) != 2 {
+		t.Errorf("%d != 2", len(refs))
+	}
+	if refs["a"].String() != "docker.io/library/nginx:1.25" {
+		t.Error(refs["a"].String())
+	}
+	if refs["b"].Registry != "ghcr.io" || refs["b"].Repository != "user/api" || refs["b"].Tag != "v1.0.0" {
+		t.Errorf("%+v", refs["b"])
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile_lib
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+// ErrNoModfile is returned by LoadModule if the repository does not contain a modfile.
+var ErrNoModfile = errors.New("modfile not found")
+
+// ModfileNames lists the file names LoadModule looks for in the repository root.
+var ModfileNames = []string{"Modfile.yml", "Modfile.yaml", "modfile.yml", "modfile.yaml", "Modfile.json", "modfile.json"}
+
+// LoadModule reads the modfile from the root of a module repository and checks that all bind mount
+// and file sources and file schemas exist in fsys. Sources must be relative and must not leave the repository.
+// Besides the module, the sorted list of repository paths referenced by the modfile is returned, including
+// included files.
+// The repository is also provided to the decoder, see WithRepoFS.
+func LoadModule(fsys fs.FS, opts ...Option) (module_lib.Module, []string, error) {
+	name, err := findModfile(fsys)
+	if err != nil {
+		return module_lib.Module{}, nil, err
+	}
+	f, err := fsys.Open(name)
+	if err != nil {
+		return module_lib.Module{}, nil, err
+	}
+	defer f.Close()
+	opts = append(slices.Clone(opts), WithRepoFS(fsys))
+	opt := newOptions(opts)
+	schemas := opt.fileSchemas
+	if schemas == nil {
+		schemas = make(map[string]string)
+		opts = append(opts, WithFileSchemas(schemas))
+	}
+	included := opt.includedFiles
+	if included == nil {
+		included = make(map[string]struct{})
+		opts = append(opts, WithIncludedFiles(included))
+	}
+	var mod module_lib.Module
+	if path.Ext(name) == ".json" {
+		mod, err = DecodeJSON(f, opts...)
+	} else {
+		mod, err = Decode(f, opts...)
+	}
+	if err != nil {
+		return module_lib.Module{}, nil, fmt.Errorf("%s: %w", name, err)
+	}
+	paths, err := checkSources(fsys, mod, schemas, included)
+	if err != nil {
+		return module_lib.Module{}, nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return mod, paths, nil
+}
+
+// findModfile lists the repository root instead of probing each name, as case-insensitive file systems would
+// match several names for the same file.
+func findModfile(fsys fs.FS) (string, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return "", err
+	}
+	var found []string
+	for _, entry := range entries {
+		if !entry.IsDir() && slices.Contains(ModfileNames, entry.Name()) {
+			found = append(found, entry.Name())
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", ErrNoModfile
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("multiple modfiles found: %s", strings.Join(found, ", "))
+	}
+}
+
+func checkSources(fsys fs.FS, mod module_lib.Module, schemas map[string]string, included map[string]struct{}) ([]string, error) {
+	paths := maputil.SortedKeys(included)
+	var errs []error
+	check := func(src, format string, args ...any) {
+		p, err := checkSource(fsys, src)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(format+": %w", append(args, err)...))
+			return
+		}
+		paths = append(paths, p)
+	}
+	for _, ref := range maputil.SortedKeys(mod.Services) {
+		for _, mp := range maputil.SortedKeys(mod.Services[ref].BindMounts) {
+			check(mod.Services[ref].BindMounts[mp].Source, "service '%s' bind mount '%s'", ref, mp)
+		}
+	}
+	for _, ref := range maputil.SortedKeys(mod.AuxServices) {
+		for _, mp := range maputil.SortedKeys(mod.AuxServices[ref].BindMounts) {
+			check(mod.AuxServices[ref].BindMounts[mp].Source, "aux service '%s' bind mount '%s'", ref, mp)
+		}
+	}
+	for _, ref := range maputil.SortedKeys(mod.Files) {
+		if src := mod.Files[ref].Source; src != "" {
+			check(src, "file '%s'", ref)
+		}
+	}
+	for _, ref := range maputil.SortedKeys(schemas) {
+		check(schemas[ref], "file '%s' schema", ref)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	slices.Sort(paths)
+	return slices.Compact(paths), nil
+}
+
+func checkSource(fsys fs.FS, src string) (string, error) {
+	if src == "" {
+		return "", errors.New("source required")
+	}
+	p, err := v1_model.RepoPath("", src)
+	if err != nil {
+		return "", fmt.Errorf("source '%s': %w", src, err)
+	}
+	if _, err := fs.Stat(fsys, p); err != nil {
+		return "", fmt.Errorf("source '%s': %w", src, err)
+	}
+	return p, nil
+}
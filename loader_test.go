@@ -0,0 +1,130 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile_lib
+
+import (
+	"errors"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+)
+
+func TestLoadModule(t *testing.T) {
+	a, err := Unmarshal([]byte(testModFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"Modfile.yml":   {Data: []byte(testModFile)},
+		"static/index":  {Data: []byte("test")},
+		"settings.json": {Data: []byte("{}")},
+	}
+	b, paths, err := LoadModule(fsys, WithStrict())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+	if c := []string{"settings.json", "static"}; reflect.DeepEqual(c, paths) == false {
+		t.Errorf("%v != %v", c, paths)
+	}
+	// ---------------------------
+	fsys["Modfile.yml"] = &fstest.MapFile{Data: []byte(strings.Replace(testModFile, "source: settings.json", "source: settings.json\n    schema: ./schemas/settings.json", 1))}
+	fsys["schemas/settings.json"] = &fstest.MapFile{Data: []byte(`{"type": "object"}`)}
+	schemas := make(map[string]string)
+	if _, paths, err = LoadModule(fsys, WithFileSchemas(schemas)); err != nil {
+		t.Fatal(err)
+	}
+	if c := []string{"schemas/settings.json", "settings.json", "static"}; reflect.DeepEqual(c, paths) == false {
+		t.Errorf("%v != %v", c, paths)
+	}
+	if schemas["settings"] != "./schemas/settings.json" {
+		t.Error(schemas)
+	}
+	delete(fsys, "schemas/settings.json")
+	if _, _, err = LoadModule(fsys); !errors.Is(err, fs.ErrNotExist) {
+		t.Error("not fs.ErrNotExist")
+	}
+	fsys["Modfile.yml"] = &fstest.MapFile{Data: []byte(strings.Replace(testModFile, "description: test module", "description: !include docs/description.yml", 1))}
+	fsys["docs/description.yml"] = &fstest.MapFile{Data: []byte("!include ./text.yml")}
+	fsys["docs/text.yml"] = &fstest.MapFile{Data: []byte("test module")}
+	if b, paths, err = LoadModule(fsys); err != nil {
+		t.Fatal(err)
+	} else if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+	if c := []string{"docs/description.yml", "docs/text.yml", "settings.json", "static"}; reflect.DeepEqual(c, paths) == false {
+		t.Errorf("%v != %v", c, paths)
+	}
+	fsys["Modfile.yml"] = &fstest.MapFile{Data: []byte(testModFile)}
+	// ---------------------------
+	fsys["settings.json"] = &fstest.MapFile{Data: []byte("{")}
+	var e *v1_model.Error
+	if _, _, err = LoadModule(fsys); !errors.As(err, &e) {
+		t.Error("not *Error")
+	} else if e.Path.String() != "files.settings.source" {
+		t.Errorf("%s != files.settings.source", e.Path)
+	}
+	// ---------------------------
+	delete(fsys, "settings.json")
+	if _, _, err = LoadModule(fsys); !errors.Is(err, fs.ErrNotExist) {
+		t.Error("not fs.ErrNotExist")
+	}
+	// ---------------------------
+	for _, src := range []string{"/static", "../static", "static/../../static", "static\\index"} {
+		fsys["settings.json"] = &fstest.MapFile{Data: []byte("{}")}
+		fsys["Modfile.yml"] = &fstest.MapFile{Data: []byte(strings.Replace(testModFile, "source: static", "source: "+src, 1))}
+		if _, _, err = LoadModule(fsys); err == nil {
+			t.Errorf("%s: err == nil", src)
+		}
+	}
+	// ---------------------------
+	fsys["modfile.yaml"] = &fstest.MapFile{Data: []byte(testModFile)}
+	if _, _, err = LoadModule(fsys); err == nil {
+		t.Error("err == nil")
+	}
+	// ---------------------------
+	if _, _, err = LoadModule(fstest.MapFS{}); !errors.Is(err, ErrNoModfile) {
+		t.Error("not ErrNoModfile")
+	}
+	// ---------------------------
+	fsys = fstest.MapFS{
+		"Modfile.yml":   {Data: []byte(testModFile)},
+		"static/index":  {Data: []byte("test")},
+		"settings.json": {Data: []byte("{}")},
+	}
+	if _, _, err = LoadModule(caseInsensitiveFS(fsys)); err != nil {
+		t.Error(err)
+	}
+}
+
+// caseInsensitiveFS opens files regardless of the case of their names, like the default file systems of macOS and Windows.
+type caseInsensitiveFS fstest.MapFS
+
+func (f caseInsensitiveFS) Open(name string) (fs.File, error) {
+	for n := range f {
+		if strings.EqualFold(n, name) {
+			return fstest.MapFS(f).Open(n)
+		}
+	}
+	return fstest.MapFS(f).Open(name)
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package moduleid
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	labelRe   = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	segmentRe = regexp.MustCompile(`^[A-Za-z0-9_~-]([A-Za-z0-9._~-]*[A-Za-z0-9_~-])?$`)
+)
+
+// Canonical validates a module ID (url without scheme, e.g. github.com/user/repo) and returns its canonical form:
+// http and https schemes and trailing slashes are removed and the host is lower-cased. The host must be a domain
+// name containing at least one dot, path segments may contain letters, digits and '-', '.', '_', '~' but must not
+// start or end with a dot.
+func Canonical(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("invalid module ID: empty")
+	}
+	s := id
+	for _, scheme := range []string{"https://", "http://"} {
+		if len(s) >= len(scheme) && strings.EqualFold(s[:len(scheme)], scheme) {
+			s = s[len(scheme):]
+			break
+		}
+	}
+	if strings.Contains(s, "://") {
+		return "", fmt.Errorf("invalid module ID '%s': unsupported scheme", id)
+	}
+	s = strings.TrimRight(s, "/")
+	segments := strings.Split(s, "/")
+	host := strings.ToLower(segments[0])
+	if !strings.Contains(host, ".") {
+		return "", fmt.Errorf("invalid module ID '%s': host '%s' must contain a dot", id, segments[0])
+	}
+	for _, label := range strings.Split(host, ".") {
+		if !labelRe.MatchString(label) {
+			return "", fmt.Errorf("invalid module ID '%s': invalid host '%s'", id, segments[0])
+		}
+	}
+	segments[0] = host
+	for _, segment := range segments[1:] {
+		if !segmentRe.MatchString(segment) {
+			return "", fmt.Errorf("invalid module ID '%s': invalid path segment '%s'", id, segment)
+		}
+	}
+	return strings.Join(segments, "/"), nil
+}
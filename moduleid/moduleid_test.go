@@ -0,0 +1,55 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package moduleid
+
+import "testing"
+
+func TestCanonical(t *testing.T) {
+	valid := map[string]string{
+		"github.com/user/repo":               "github.com/user/repo",
+		"GitHub.com/User/Repo":               "github.com/User/Repo",
+		"https://github.com/user/repo/":      "github.com/user/repo",
+		"HTTP://example.org/a/b.c/d_e~f//":   "example.org/a/b.c/d_e~f",
+		"git.example-host.org/mgw-module.v2": "git.example-host.org/mgw-module.v2",
+		"example.com":                        "example.com",
+	}
+	for s, a := range valid {
+		c, err := Canonical(s)
+		if err != nil {
+			t.Errorf("%s: %s", s, err)
+		} else if c != a {
+			t.Errorf("%s: %s != %s", s, c, a)
+		}
+	}
+	invalid := []string{
+		"",
+		"repo",
+		"ftp://github.com/user/repo",
+		"github.com/user repo",
+		"github.com//repo",
+		"github.com/user/../repo",
+		"github.com/.hidden",
+		"github.com:8080/user/repo",
+		"-github.com/user/repo",
+		"github..com/user/repo",
+	}
+	for _, s := range invalid {
+		if _, err := Canonical(s); err == nil {
+			t.Errorf("%s: err == nil", s)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile_lib
+
+import (
+	"io/fs"
+
+	v1_generator "github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator"
+)
+
+// Option configures the decoding of modfiles.
+type Option func(*options)
+
+type options struct {
+	strict         bool
+	maxSize        int64
+	versions       []string
+	warn           func(error)
+	fsys           fs.FS
+	vars           map[string]string
+	maxNodes       int
+	maxDepth       int
+	maxPorts       int
+	maxIncludeSize int64
+	maxIncludes    int
+	mutableTags    []string
+	fileSchemas    map[string]string
+	includedFiles  map[string]struct{}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func (o options) generatorOptions() v1_generator.Options {
+	return v1_generator.Options{
+		Strict:         o.strict,
+		Warn:           o.warn,
+		FS:             o.fsys,
+		Vars:           o.vars,
+		MaxNodes:       o.maxNodes,
+		MaxDepth:       o.maxDepth,
+		MaxPorts:       o.maxPorts,
+		MaxSize:        o.maxSize,
+		MaxIncludeSize: o.maxIncludeSize,
+		MaxIncludes:    o.maxIncludes,
+		MutableTags:    o.mutableTags,
+		FileSchemas:    o.fileSchemas,
+		IncludedFiles:  o.includedFiles,
+	}
+}
+
+// WithStrict causes fields not defined by the modfile version to be reported as errors.
+func WithStrict() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// WithMaxSize limits the size of a modfile and of each repository file it references to n bytes, see WithRepoFS.
+// The modfiles of a stream are limited separately, see DecodeAll.
+func WithMaxSize(n int64) Option {
+	return func(o *options) {
+		o.maxSize = n
+	}
+}
+
+// WithMaxNodes limits the number of nodes of a modfile with aliases expanded, protecting against alias expansion attacks.
+func WithMaxNodes(n int) Option {
+	return func(o *options) {
+		o.maxNodes = n
+	}
+}
+
+// WithMaxDepth limits the nesting depth of a modfile with aliases expanded.
+func WithMaxDepth(n int) Option {
+	return func(o *options) {
+		o.maxDepth = n
+	}
+}
+
+// WithMaxPorts limits the total number of ports of all services with port ranges expanded.
+func WithMaxPorts(n int) Option {
+	return func(o *options) {
+		o.maxPorts = n
+	}
+}
+
+// WithMaxIncludes limits the number of includes resolved for a modfile, see WithRepoFS. The size of each included
+// file is limited by WithMaxSize.
+func WithMaxIncludes(n int) Option {
+	return func(o *options) {
+		o.maxIncludes = n
+	}
+}
+
+// WithMaxIncludeSize limits the total number of bytes read from included files of a modfile.
+func WithMaxIncludeSize(n int64) Option {
+	return func(o *options) {
+		o.maxIncludeSize = n
+	}
+}
+
+// WithMutableTags sets the image tags that are rejected unless the image is pinned by digest.
+// Defaults to imageref.DefaultMutableTags, pass no tags to accept all tags.
+func WithMutableTags(tags ...string) Option {
+	return func(o *options) {
+		o.mutableTags = append([]string{}, tags...)
+	}
+}
+
+// WithVersions restricts the accepted modfile versions.
+func WithVersions(versions ...string) Option {
+	return func(o *options) {
+		o.versions = versions
+	}
+}
+
+// WithWarnings sets a function receiving problems that do not cause the decoding to fail,
+// e.g. unknown fields if strict mode is not enabled.
+func WithWarnings(f func(error)) Option {
+	return func(o *options) {
+		o.warn = f
+	}
+}
+
+// WithRepoFS provides access to the module repository for features resolving repository files.
+func WithRepoFS(fsys fs.FS) Option {
+	return func(o *options) {
+		o.fsys = fsys
+	}
+}
+
+// WithVariables enables the expansion of ${VAR} and ${VAR:-default} references in scalar values using vars.
+// Undefined variables without default are reported as errors. The process environment is never read.
+func WithVariables(vars map[string]string) Option {
+	return func(o *options) {
+		if vars == nil {
+			vars = make(map[string]string)
+		}
+		o.vars = vars
+	}
+}
+
+// WithFileSchemas collects the schema path of each file declaring one in schemas, keyed by file reference.
+// Generated modules do not carry schema paths, use them to validate content provided by users, see
+// validation.ValidateContent.
+func WithFileSchemas(schemas map[string]string) Option {
+	return func(o *options) {
+		o.fileSchemas = schemas
+	}
+}
+
+// WithIncludedFiles collects the repository path of each file included by the modfile in files, see WithRepoFS.
+func WithIncludedFiles(files map[string]struct{}) Option {
+	return func(o *options) {
+		o.includedFiles = files
+	}
+}
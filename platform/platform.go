@@ -0,0 +1,203 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platform
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+const (
+	OSLinux = "linux"
+)
+
+const (
+	Arch386   = "386"
+	ArchAmd64 = "amd64"
+	ArchArm   = "arm"
+	ArchArm64 = "arm64"
+)
+
+// Platform is a canonical platform identifier as used by OCI image indexes (e.g. linux/arm/v7).
+type Platform struct {
+	OS           string
+	Architecture string
+	Variant      string
+}
+
+var aliases = map[string]Platform{
+	"x86":     {OS: OSLinux, Architecture: Arch386},
+	"i386":    {OS: OSLinux, Architecture: Arch386},
+	"i686":    {OS: OSLinux, Architecture: Arch386},
+	"386":     {OS: OSLinux, Architecture: Arch386},
+	"x86_64":  {OS: OSLinux, Architecture: ArchAmd64},
+	"amd64":   {OS: OSLinux, Architecture: ArchAmd64},
+	"aarch32": {OS: OSLinux, Architecture: ArchArm, Variant: "v7"},
+	"arm":     {OS: OSLinux, Architecture: ArchArm, Variant: "v7"},
+	"armhf":   {OS: OSLinux, Architecture: ArchArm, Variant: "v7"},
+	"arm32v5": {OS: OSLinux, Architecture: ArchArm, Variant: "v5"},
+	"armv5":   {OS: OSLinux, Architecture: ArchArm, Variant: "v5"},
+	"arm32v6": {OS: OSLinux, Architecture: ArchArm, Variant: "v6"},
+	"armv6":   {OS: OSLinux, Architecture: ArchArm, Variant: "v6"},
+	"armv6l":  {OS: OSLinux, Architecture: ArchArm, Variant: "v6"},
+	"arm32v7": {OS: OSLinux, Architecture: ArchArm, Variant: "v7"},
+	"armv7":   {OS: OSLinux, Architecture: ArchArm, Variant: "v7"},
+	"armv7l":  {OS: OSLinux, Architecture: ArchArm, Variant: "v7"},
+	"aarch64": {OS: OSLinux, Architecture: ArchArm64},
+	"arm64":   {OS: OSLinux, Architecture: ArchArm64},
+	"arm64v8": {OS: OSLinux, Architecture: ArchArm64},
+	"armv8":   {OS: OSLinux, Architecture: ArchArm64},
+}
+
+// Parse parses an architecture alias (e.g. x86_64, arm32v7, aarch64) or a platform identifier in the form
+// os/arch[/variant] and returns the canonical platform. Aliases imply the linux operating system.
+func Parse(s string) (Platform, error) {
+	parts := strings.Split(strings.ToLower(s), "/")
+	if len(parts) == 1 {
+		p, ok := aliases[parts[0]]
+		if !ok {
+			return Platform{}, fmt.Errorf("unknown architecture '%s'", s)
+		}
+		return p, nil
+	}
+	if len(parts) > 3 || parts[0] == "" {
+		return Platform{}, fmt.Errorf("invalid platform '%s'", s)
+	}
+	p, ok := aliases[parts[1]]
+	if !ok {
+		return Platform{}, fmt.Errorf("invalid platform '%s': unknown architecture '%s'", s, parts[1])
+	}
+	p.OS = parts[0]
+	if len(parts) == 3 {
+		variant, err := normalizeVariant(p.Architecture, parts[2])
+		if err != nil {
+			return Platform{}, fmt.Errorf("invalid platform '%s': %s", s, err)
+		}
+		if p.Architecture == ArchArm && parts[1] != ArchArm && variant != p.Variant {
+			return Platform{}, fmt.Errorf("invalid platform '%s': variant does not match architecture '%s'", s, parts[1])
+		}
+		p.Variant = variant
+	}
+	return p, nil
+}
+
+// FromGo returns the canonical platform for the given values of GOOS, GOARCH and GOARM.
+// GOARM defaults to 7 if empty, floating point suffixes (e.g. 7,softfloat) are ignored.
+func FromGo(goos, goarch, goarm string) (Platform, error) {
+	switch goarch {
+	case Arch386, ArchAmd64, ArchArm64:
+		return Platform{OS: goos, Architecture: goarch}, nil
+	case ArchArm:
+		level, _, _ := strings.Cut(goarm, ",")
+		if level == "" {
+			level = "7"
+		}
+		variant, err := normalizeVariant(ArchArm, level)
+		if err != nil {
+			return Platform{}, fmt.Errorf("invalid GOARM '%s'", goarm)
+		}
+		return Platform{OS: goos, Architecture: ArchArm, Variant: variant}, nil
+	default:
+		return Platform{}, fmt.Errorf("unsupported GOARCH '%s'", goarch)
+	}
+}
+
+// Current returns the platform the running binary was built for.
+func Current() (Platform, error) {
+	var goarm string
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "GOARM" {
+				goarm = setting.Value
+			}
+		}
+	}
+	return FromGo(runtime.GOOS, runtime.GOARCH, goarm)
+}
+
+func (p Platform) String() string {
+	s := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+	return s
+}
+
+// Alias returns the architecture name used by the modfile and Docker official images (e.g. arm32v7)
+// or an empty string if the platform has no such name.
+func (p Platform) Alias() string {
+	if p.OS != OSLinux {
+		return ""
+	}
+	switch p.Architecture {
+	case Arch386:
+		return "i386"
+	case ArchAmd64:
+		return "amd64"
+	case ArchArm:
+		return "arm32" + p.Variant
+	case ArchArm64:
+		return "arm64v8"
+	}
+	return ""
+}
+
+// Supports reports if a binary built for the given platform can run on p.
+// ARM variants are backwards compatible, e.g. linux/arm/v7 supports linux/arm/v6.
+func (p Platform) Supports(o Platform) bool {
+	if p.OS != o.OS || p.Architecture != o.Architecture {
+		return false
+	}
+	if p.Architecture == ArchArm {
+		return o.Variant <= p.Variant
+	}
+	return p.Variant == o.Variant
+}
+
+// SupportsPlatform reports if the module can run on the given platform. Modules without
+// architectures are considered platform independent.
+func SupportsPlatform(mod module_lib.Module, p Platform) bool {
+	if len(mod.Architectures) == 0 {
+		return true
+	}
+	for arch := range mod.Architectures {
+		if m, err := Parse(arch); err == nil && p.Supports(m) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeVariant(arch, variant string) (string, error) {
+	v := strings.TrimPrefix(variant, "v")
+	switch arch {
+	case ArchArm:
+		switch v {
+		case "5", "6", "7":
+			return "v" + v, nil
+		}
+	case ArchArm64:
+		if v == "8" {
+			return "", nil
+		}
+	}
+	return "", fmt.Errorf("unknown variant '%s' for architecture '%s'", variant, arch)
+}
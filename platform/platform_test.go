@@ -0,0 +1,130 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platform
+
+import (
+	"testing"
+
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func TestParse(t *testing.T) {
+	valid := map[string]string{
+		"x86":            "linux/386",
+		"i386":           "linux/386",
+		"x86_64":         "linux/amd64",
+		"AMD64":          "linux/amd64",
+		"aarch32":        "linux/arm/v7",
+		"arm32v5":        "linux/arm/v5",
+		"arm32v6":        "linux/arm/v6",
+		"arm32v7":        "linux/arm/v7",
+		"aarch64":        "linux/arm64",
+		"arm64v8":        "linux/arm64",
+		"linux/amd64":    "linux/amd64",
+		"linux/x86_64":   "linux/amd64",
+		"linux/arm":      "linux/arm/v7",
+		"linux/arm/v6":   "linux/arm/v6",
+		"linux/arm/6":    "linux/arm/v6",
+		"linux/arm64/v8": "linux/arm64",
+	}
+	for s, a := range valid {
+		p, err := Parse(s)
+		if err != nil {
+			t.Errorf("%s: %s", s, err)
+		} else if p.String() != a {
+			t.Errorf("%s: %s != %s", s, p, a)
+		}
+	}
+	invalid := []string{"", "sparc", "/amd64", "linux/sparc", "linux/amd64/v2", "linux/arm/v8", "linux/arm32v7/v6", "linux/arm/v7/x"}
+	for _, s := range invalid {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("%s: err == nil", s)
+		}
+	}
+}
+
+func TestFromGo(t *testing.T) {
+	tests := []struct {
+		goarch, goarm, a string
+	}{
+		{"386", "", "linux/386"},
+		{"amd64", "", "linux/amd64"},
+		{"arm", "", "linux/arm/v7"},
+		{"arm", "6", "linux/arm/v6"},
+		{"arm", "5,softfloat", "linux/arm/v5"},
+		{"arm64", "", "linux/arm64"},
+	}
+	for _, tc := range tests {
+		p, err := FromGo("linux", tc.goarch, tc.goarm)
+		if err != nil {
+			t.Errorf("%s %s: %s", tc.goarch, tc.goarm, err)
+		} else if p.String() != tc.a {
+			t.Errorf("%s %s: %s != %s", tc.goarch, tc.goarm, p, tc.a)
+		}
+	}
+	if _, err := FromGo("linux", "riscv64", ""); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err := FromGo("linux", "arm", "8"); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestAlias(t *testing.T) {
+	for _, s := range []string{"i386", "amd64", "arm32v5", "arm32v6", "arm32v7", "arm64v8"} {
+		p, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Alias() != s {
+			t.Errorf("%s != %s", p.Alias(), s)
+		}
+	}
+	if a := (Platform{OS: "windows", Architecture: ArchAmd64}).Alias(); a != "" {
+		t.Error(a)
+	}
+}
+
+func TestSupportsPlatform(t *testing.T) {
+	armV7 := Platform{OS: OSLinux, Architecture: ArchArm, Variant: "v7"}
+	armV5 := Platform{OS: OSLinux, Architecture: ArchArm, Variant: "v5"}
+	amd64 := Platform{OS: OSLinux, Architecture: ArchAmd64}
+	if !SupportsPlatform(module_lib.Module{}, amd64) {
+		t.Error("module without architectures not supported")
+	}
+	mod := module_lib.Module{
+		Architectures: map[module_lib.CPUArch]struct{}{
+			"linux/amd64":  {},
+			"linux/arm/v6": {},
+		},
+	}
+	if !SupportsPlatform(mod, amd64) {
+		t.Error("amd64 not supported")
+	}
+	if !SupportsPlatform(mod, armV7) {
+		t.Error("arm/v7 not supported")
+	}
+	if SupportsPlatform(mod, armV5) {
+		t.Error("arm/v5 supported")
+	}
+	if SupportsPlatform(mod, Platform{OS: OSLinux, Architecture: ArchArm64}) {
+		t.Error("arm64 supported")
+	}
+	if SupportsPlatform(mod, Platform{OS: "windows", Architecture: ArchAmd64}) {
+		t.Error("windows/amd64 supported")
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile_lib
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	v1_generator "github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator"
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	v2_generator "github.com/SENERGY-Platform/mgw-modfile-lib/v2/generator"
+	v2_model "github.com/SENERGY-Platform/mgw-modfile-lib/v2/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Handler generates a module from a modfile document of a specific version.
+type Handler func(yn *yaml.Node) (module_lib.Module, error)
+
+// handler generates a module from a modfile document prepared by v1 generator.Prepare, see getModule.
+type handler func(yn *yaml.Node, inc v1_model.Includes, opt v1_generator.Options) (module_lib.Module, error)
+
+var registry = struct {
+	mu       sync.RWMutex
+	handlers map[string]handler
+}{
+	handlers: map[string]handler{
+		v1_model.Version: v1_generator.Generate,
+		v2_model.Version: v2_generator.Generate,
+	},
+}
+
+// jsonHandlers replace the handlers of the built-in versions for JSON modfiles, see DecodeJSON.
+var jsonHandlers = map[string]handler{
+	v1_model.Version: v1_generator.GenerateJSON,
+	v2_model.Version: v2_generator.GenerateJSON,
+}
+
+// Register adds a handler for the given modfile version. Versions can only be registered once.
+// Handlers registered this way do not receive decoder options, but includes, limits and variables
+// (see WithRepoFS, WithMaxNodes and WithVariables) are applied before the handler is called.
+func Register(version string, h Handler) error {
+	if version == "" {
+		return errors.New("invalid modfile version")
+	}
+	if h == nil {
+		return errors.New("invalid handler")
+	}
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	if _, ok := registry.handlers[version]; ok {
+		return errors.New("modfile version already registered: " + version)
+	}
+	registry.handlers[version] = func(yn *yaml.Node, _ v1_model.Includes, _ v1_generator.Options) (module_lib.Module, error) {
+		return h(yn)
+	}
+	return nil
+}
+
+// Versions returns all supported modfile versions in ascending order.
+func Versions() []string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	var versions []string
+	for v := range registry.handlers {
+		versions = append(versions, v)
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+func getHandler(version string) (handler, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	h, ok := registry.handlers[version]
+	return h, ok
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modfile_lib
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+func TestRegister(t *testing.T) {
+	version := "test"
+	h := func(yn *yaml.Node) (module_lib.Module, error) {
+		var m struct {
+			ID string `yaml:"id"`
+		}
+		if err := yn.Decode(&m); err != nil {
+			return module_lib.Module{}, err
+		}
+		return module_lib.Module{ID: m.ID}, nil
+	}
+	if err := Register(version, h); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { unregister(version) })
+	if err := Register(version, h); err == nil {
+		t.Error("err == nil")
+	}
+	if err := Register(v1_model.Version, h); err == nil {
+		t.Error("err == nil")
+	}
+	if err := Register("", h); err == nil {
+		t.Error("err == nil")
+	}
+	if err := Register("test2", nil); err == nil {
+		t.Error("err == nil")
+	}
+	// ---------------------------
+	if v := Versions(); !slices.Contains(v, version) {
+		t.Errorf("%v", v)
+	}
+	// ---------------------------
+	mod, err := Unmarshal([]byte("modfileVersion: test\nid: test\n"))
+	if err != nil {
+		t.Error(err)
+	} else if mod.ID != "test" {
+		t.Errorf("%s != test", mod.ID)
+	}
+	// ---------------------------
+	if _, err = Unmarshal([]byte("modfileVersion: test\nid: test\n"), WithVersions(v1_model.Version)); err == nil {
+		t.Error("err == nil")
+	}
+	var lErr *LimitError
+	if _, err = Unmarshal([]byte("modfileVersion: test\nid: test\n"), WithMaxNodes(3)); !errors.As(err, &lErr) {
+		t.Error("not *LimitError")
+	}
+}
+
+func unregister(version string) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	delete(registry.handlers, version)
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resolver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MissingModuleError indicates a required module that is not available. RequiredBy lists the dependents
+// as "ID version", an empty string stands for the requested modules.
+type MissingModuleError struct {
+	ID         string
+	RequiredBy []string
+}
+
+func (e *MissingModuleError) Error() string {
+	return fmt.Sprintf("module '%s' required by %s not available", e.ID, formatIDs(e.RequiredBy))
+}
+
+// UnsatisfiableError indicates that no available version of a module satisfies all requirements.
+// Requirements lists the version constraints and required services of the dependents.
+type UnsatisfiableError struct {
+	ID           string
+	Requirements []string
+}
+
+func (e *UnsatisfiableError) Error() string {
+	return fmt.Sprintf("no version of module '%s' satisfies: %s", e.ID, strings.Join(e.Requirements, ", "))
+}
+
+// CycleError indicates modules depending on each other. The first and last element of Cycle are the same module.
+type CycleError struct {
+	Cycle []string
+}
+
+func (e *CycleError) Error() string {
+	return "dependency cycle: " + strings.Join(e.Cycle, " -> ")
+}
+
+func formatIDs(ids []string) string {
+	quoted := make([]string, len(ids))
+	for i, id := range ids {
+		if id == "" {
+			quoted[i] = "request"
+		} else {
+			quoted[i] = "'" + id + "'"
+		}
+	}
+	return strings.Join(quoted, ", ")
+}
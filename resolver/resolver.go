@@ -0,0 +1,333 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/semver"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+// Resolver selects module versions from a set of available modules.
+type Resolver struct {
+	modules map[string][]candidate
+}
+
+type candidate struct {
+	module  module_lib.Module
+	version semver.Version
+}
+
+type requirement struct {
+	from       string
+	raw        string
+	constraint semver.Constraint
+	services   []string
+}
+
+func (r requirement) String() string {
+	s := r.raw
+	if len(r.services) > 0 {
+		s += " with services " + strings.Join(r.services, ", ")
+	}
+	if r.from != "" {
+		s += " (" + r.from + ")"
+	}
+	return s
+}
+
+// New returns a Resolver for the available modules, which may contain several versions per module.
+// Module versions must be semantic versions and each version of a module may only be provided once.
+func New(modules []module_lib.Module) (*Resolver, error) {
+	r := &Resolver{modules: make(map[string][]candidate)}
+	for _, mod := range modules {
+		v, err := semver.ParseVersion(mod.Version)
+		if err != nil {
+			return nil, fmt.Errorf("module '%s': %w", mod.ID, err)
+		}
+		for _, c := range r.modules[mod.ID] {
+			if c.version.Compare(v) == 0 {
+				return nil, fmt.Errorf("module '%s': duplicate version '%s'", mod.ID, mod.Version)
+			}
+		}
+		r.modules[mod.ID] = append(r.modules[mod.ID], candidate{module: mod, version: v})
+	}
+	for _, cs := range r.modules {
+		slices.SortFunc(cs, func(a, b candidate) int {
+			return b.version.Compare(a.version)
+		})
+	}
+	return r, nil
+}
+
+// Resolve selects a version for each requested module (ID -> version constraint) and all of their direct and
+// indirect dependencies. Newer versions are preferred. A selected dependency must satisfy the version constraints
+// of all dependents and define the services they require. The selected modules are returned in install order,
+// dependencies first. Problems are reported as *MissingModuleError, *UnsatisfiableError or *CycleError.
+// References of the selected modules to services of their dependencies are checked with CheckExtDependencies
+// after resolving, problems are reported as *ExtDependencyError.
+func (r *Resolver) Resolve(requested map[string]string) ([]module_lib.Module, error) {
+	s := state{
+		selected:     make(map[string]candidate),
+		requirements: make(map[string][]requirement),
+	}
+	for id, raw := range requested {
+		c, err := semver.ParseConstraint(raw)
+		if err != nil {
+			return nil, fmt.Errorf("module '%s': %w", id, err)
+		}
+		s.requirements[id] = append(s.requirements[id], requirement{raw: raw, constraint: c})
+	}
+	if err := r.resolve(&s); err != nil {
+		return nil, err
+	}
+	mods, err := installOrder(s.selected)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for _, mod := range mods {
+		deps := make(map[string]module_lib.Module)
+		for depID := range mod.Dependencies {
+			deps[depID] = s.selected[depID].module
+		}
+		if err = CheckExtDependencies(mod, deps); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return mods, nil
+}
+
+func (r *Resolver) resolve(s *state) error {
+	id := s.next()
+	if id == "" {
+		return nil
+	}
+	cs, ok := r.modules[id]
+	if !ok {
+		return &MissingModuleError{ID: id, RequiredBy: s.requiredBy(id)}
+	}
+	var firstErr error
+	for _, c := range cs {
+		if !s.accepts(id, c) {
+			continue
+		}
+		err := s.selectModule(c)
+		if err == nil {
+			if err = r.resolve(s); err == nil {
+				return nil
+			}
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		s.deselect(c)
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	return s.unsatisfiable(id)
+}
+
+type state struct {
+	selected     map[string]candidate
+	requirements map[string][]requirement
+}
+
+// next returns the first module in alphabetical order that is required but not selected.
+func (s *state) next() string {
+	var ids []string
+	for id, reqs := range s.requirements {
+		if _, ok := s.selected[id]; !ok && len(reqs) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return ""
+	}
+	return slices.Min(ids)
+}
+
+func (s *state) accepts(id string, c candidate) bool {
+	for _, req := range s.requirements[id] {
+		if !req.constraint.Check(c.version) {
+			return false
+		}
+		for _, srv := range req.services {
+			if _, ok := c.module.Services[srv]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// selectModule adds the requirements of the candidate for its dependencies, dependencies that are already
+// selected must satisfy them and must not depend on the candidate, so that cycles fail the candidate.
+func (s *state) selectModule(c candidate) error {
+	s.selected[c.module.ID] = c
+	services := requiredServices(c.module)
+	for _, depID := range maputil.SortedKeys(c.module.Dependencies) {
+		raw := c.module.Dependencies[depID]
+		constraint, err := semver.ParseConstraint(raw)
+		if err != nil {
+			return fmt.Errorf("module '%s' %s: dependency '%s': %w", c.module.ID, c.module.Version, depID, err)
+		}
+		s.requirements[depID] = append(s.requirements[depID], requirement{
+			from:       c.module.ID + " " + c.module.Version,
+			raw:        raw,
+			constraint: constraint,
+			services:   services[depID],
+		})
+		if dep, ok := s.selected[depID]; ok {
+			if !s.accepts(depID, dep) {
+				return s.unsatisfiable(depID)
+			}
+			if cycle := s.cycle(c.module.ID, depID); cycle != nil {
+				return &CycleError{Cycle: cycle}
+			}
+		}
+	}
+	return nil
+}
+
+// cycle returns the cycle closed by a dependency of module id on depID through the selected modules or nil.
+// The cycle starts with its alphabetically first module.
+func (s *state) cycle(id, depID string) []string {
+	path := s.path(depID, id, make(map[string]bool))
+	if path == nil {
+		return nil
+	}
+	ids := append([]string{id}, path[:len(path)-1]...)
+	i := slices.Index(ids, slices.Min(ids))
+	return append(slices.Concat(ids[i:], ids[:i]), ids[i])
+}
+
+// path returns the selected modules leading from one module to another via dependencies, including both.
+func (s *state) path(from, to string, visited map[string]bool) []string {
+	if from == to {
+		return []string{to}
+	}
+	if visited[from] {
+		return nil
+	}
+	visited[from] = true
+	for _, depID := range maputil.SortedKeys(s.selected[from].module.Dependencies) {
+		if _, ok := s.selected[depID]; !ok {
+			continue
+		}
+		if p := s.path(depID, to, visited); p != nil {
+			return append([]string{from}, p...)
+		}
+	}
+	return nil
+}
+
+func (s *state) deselect(c candidate) {
+	delete(s.selected, c.module.ID)
+	from := c.module.ID + " " + c.module.Version
+	for id, reqs := range s.requirements {
+		s.requirements[id] = slices.DeleteFunc(reqs, func(req requirement) bool {
+			return req.from == from
+		})
+	}
+}
+
+func (s *state) requiredBy(id string) []string {
+	var ids []string
+	for _, req := range s.requirements[id] {
+		ids = append(ids, req.from)
+	}
+	return ids
+}
+
+func (s *state) unsatisfiable(id string) error {
+	var reqs []string
+	for _, req := range s.requirements[id] {
+		reqs = append(reqs, req.String())
+	}
+	return &UnsatisfiableError{ID: id, Requirements: reqs}
+}
+
+// requiredServices returns the services required per dependency by the services of a module.
+func requiredServices(mod module_lib.Module) map[string][]string {
+	services := make(map[string][]string)
+	add := func(targets map[string]module_lib.ExtDependencyTarget) {
+		for _, target := range targets {
+			if !slices.Contains(services[target.ID], target.Service) {
+				services[target.ID] = append(services[target.ID], target.Service)
+			}
+		}
+	}
+	for _, srv := range mod.Services {
+		add(srv.ExtDependencies)
+	}
+	for _, srv := range mod.AuxServices {
+		add(srv.ExtDependencies)
+	}
+	for _, srvs := range services {
+		slices.Sort(srvs)
+	}
+	return services
+}
+
+// installOrder sorts the modules topologically, ties are broken alphabetically.
+func installOrder(selected map[string]candidate) ([]module_lib.Module, error) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	marks := make(map[string]int)
+	var order []module_lib.Module
+	var stack []string
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch marks[id] {
+		case done:
+			return nil
+		case visiting:
+			i := slices.Index(stack, id)
+			return &CycleError{Cycle: append(slices.Clone(stack[i:]), id)}
+		}
+		marks[id] = visiting
+		stack = append(stack, id)
+		mod := selected[id].module
+		for _, depID := range maputil.SortedKeys(mod.Dependencies) {
+			if err := visit(depID); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		marks[id] = done
+		order = append(order, mod)
+		return nil
+	}
+	for _, id := range maputil.SortedKeys(selected) {
+		if err := visit(id); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resolver
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func testModule(id, version string, deps map[string]string, services ...string) module_lib.Module {
+	mod := module_lib.Module{
+		ID:           id,
+		Version:      version,
+		Dependencies: deps,
+		Services:     make(map[string]module_lib.Service),
+	}
+	for _, srv := range services {
+		mod.Services[srv] = module_lib.Service{}
+	}
+	return mod
+}
+
+func withExtDependency(mod module_lib.Module, srv, depID, depSrv string) module_lib.Module {
+	s := mod.Services[srv]
+	if s.ExtDependencies == nil {
+		s.ExtDependencies = make(map[string]module_lib.ExtDependencyTarget)
+	}
+	s.ExtDependencies["REF_"+depSrv] = module_lib.ExtDependencyTarget{ID: depID, Service: depSrv}
+	mod.Services[srv] = s
+	return mod
+}
+
+func versions(mods []module_lib.Module) []string {
+	var vs []string
+	for _, mod := range mods {
+		vs = append(vs, mod.ID+" "+mod.Version)
+	}
+	return vs
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	modules := []module_lib.Module{
+		testModule("app", "v1.0.0", map[string]string{"db": ">=v1.0.0", "lib": ">=v1.0.0"}, "api"),
+		testModule("app", "v2.0.0", map[string]string{"db": ">=v2.0.0", "lib": ">=v1.0.0;<v2.0.0"}, "api"),
+		testModule("db", "v1.0.0", nil, "db"),
+		testModule("db", "v2.0.0", map[string]string{"lib": "v1.1.0"}, "db"),
+		testModule("db", "v3.0.0-rc.1", nil, "db"),
+		testModule("lib", "v1.0.0", nil, "lib"),
+		testModule("lib", "v1.1.0", nil, "lib"),
+		testModule("lib", "v2.0.0", nil, "lib"),
+	}
+	r, err := New(modules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mods, err := r.Resolve(map[string]string{"app": ">=v1.0.0"}); err != nil {
+		t.Error(err)
+	} else if a, b := []string{"db v3.0.0-rc.1", "lib v1.1.0", "app v2.0.0"}, versions(mods); !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	// ---------------------------
+	if mods, err := r.Resolve(map[string]string{"app": ">=v1.0.0", "db": "<v3.0.0-0"}); err != nil {
+		t.Error(err)
+	} else if a, b := []string{"lib v1.1.0", "db v2.0.0", "app v2.0.0"}, versions(mods); !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	// ---------------------------
+	if mods, err := r.Resolve(map[string]string{"app": ">=v1.0.0", "lib": "v2.0.0"}); err != nil {
+		t.Error(err)
+	} else if a, b := []string{"db v3.0.0-rc.1", "lib v2.0.0", "app v1.0.0"}, versions(mods); !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	// ---------------------------
+	_, err = r.Resolve(map[string]string{"app": "v2.0.0", "lib": "v2.0.0"})
+	var uErr *UnsatisfiableError
+	if !errors.As(err, &uErr) {
+		t.Errorf("not *UnsatisfiableError: %v", err)
+	} else if uErr.ID != "lib" {
+		t.Errorf("%s != lib", uErr.ID)
+	}
+	// ---------------------------
+	_, err = r.Resolve(map[string]string{"missing": ">=v1.0.0"})
+	var mErr *MissingModuleError
+	if !errors.As(err, &mErr) {
+		t.Errorf("not *MissingModuleError: %v", err)
+	} else if mErr.ID != "missing" {
+		t.Errorf("%s != missing", mErr.ID)
+	}
+}
+
+func TestResolver_ResolveServices(t *testing.T) {
+	modules := []module_lib.Module{
+		withExtDependency(testModule("app", "v1.0.0", map[string]string{"db": ">=v1.0.0"}, "api"), "api", "db", "postgres"),
+		testModule("db", "v1.0.0", nil, "postgres"),
+		testModule("db", "v2.0.0", nil, "mysql"),
+	}
+	r, err := New(modules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mods, err := r.Resolve(map[string]string{"app": "v1.0.0"}); err != nil {
+		t.Error(err)
+	} else if a, b := []string{"db v1.0.0", "app v1.0.0"}, versions(mods); !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	// ---------------------------
+	_, err = r.Resolve(map[string]string{"app": "v1.0.0", "db": "v2.0.0"})
+	var uErr *UnsatisfiableError
+	if !errors.As(err, &uErr) {
+		t.Errorf("not *UnsatisfiableError: %v", err)
+	} else if uErr.ID != "db" {
+		t.Errorf("%s != db", uErr.ID)
+	}
+	// ---------------------------
+	modules[0].Services["api"].ExtDependencies["REF_postgres"] = module_lib.ExtDependencyTarget{ID: "db", Service: "postgres", Template: "http://{ref}:8080"}
+	if r, err = New(modules); err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.Resolve(map[string]string{"app": "v1.0.0"})
+	var eErr *ExtDependencyError
+	if !errors.As(err, &eErr) {
+		t.Errorf("not *ExtDependencyError: %v", err)
+	} else if eErr.Service != "api" || eErr.Target.ID != "db" || !errors.Is(eErr, ErrHttpEndpointMissing) {
+		t.Error(eErr)
+	}
+}
+
+func TestResolver_ResolveCycle(t *testing.T) {
+	modules := []module_lib.Module{
+		testModule("a", "v1.0.0", map[string]string{"b": "v1.0.0"}),
+		testModule("b", "v1.0.0", map[string]string{"c": "v1.0.0"}),
+		testModule("c", "v1.0.0", map[string]string{"a": "v1.0.0"}),
+	}
+	r, err := New(modules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.Resolve(map[string]string{"a": "v1.0.0"})
+	var cErr *CycleError
+	if !errors.As(err, &cErr) {
+		t.Errorf("not *CycleError: %v", err)
+	} else if a := []string{"a", "b", "c", "a"}; !reflect.DeepEqual(a, cErr.Cycle) {
+		t.Errorf("%v != %v", a, cErr.Cycle)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New([]module_lib.Module{testModule("a", "1.0.0", nil)}); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err := New([]module_lib.Module{testModule("a", "v1.0.0", nil), testModule("a", "v1.0.0+build", nil)}); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestResolver_ResolveCycleBacktrack(t *testing.T) {
+	modules := []module_lib.Module{
+		testModule("a", "v1.0.0", map[string]string{"b": ">=v1.0.0"}),
+		testModule("b", "v2.0.0", map[string]string{"a": ">=v1.0.0"}),
+		testModule("b", "v1.0.0", nil),
+	}
+	r, err := New(modules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mods, err := r.Resolve(map[string]string{"a": ">=v1.0.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := []string{"b v1.0.0", "a v1.0.0"}; !reflect.DeepEqual(a, versions(mods)) {
+		t.Errorf("%v != %v", a, versions(mods))
+	}
+}
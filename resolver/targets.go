@@ -0,0 +1,133 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+var (
+	ErrModuleNotProvided   = errors.New("module not provided")
+	ErrServiceNotDefined   = errors.New("service not defined")
+	ErrAuxService          = errors.New("service is an aux service")
+	ErrHttpEndpointMissing = errors.New("http endpoint not defined")
+)
+
+// ExtDependencyError describes a reference of a service to a service of another module that can't be satisfied.
+// Err is one of ErrModuleNotProvided, ErrServiceNotDefined, ErrAuxService or wraps ErrHttpEndpointMissing.
+type ExtDependencyError struct {
+	Service string
+	Aux     bool
+	RefVar  string
+	Target  module_lib.ExtDependencyTarget
+	Err     error
+}
+
+func (e *ExtDependencyError) Error() string {
+	kind := "service"
+	if e.Aux {
+		kind = "aux service"
+	}
+	return fmt.Sprintf("%s '%s' reference '%s' to module '%s' service '%s': %s", kind, e.Service, e.RefVar, e.Target.ID, e.Target.Service, e.Err)
+}
+
+func (e *ExtDependencyError) Unwrap() error {
+	return e.Err
+}
+
+// CheckExtDependencies checks the references of the services and aux services of mod to services of the
+// dependency modules (ID -> module). Referenced services must be defined as services, not as aux services,
+// and templates with an http or https URL (e.g. http://{ref}:8080/api) require an http endpoint of the
+// referenced service listening on the port with a path that is a prefix of the URL path.
+// All problems are returned as *ExtDependencyError.
+func CheckExtDependencies(mod module_lib.Module, deps map[string]module_lib.Module) error {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mod.Services) {
+		errs = append(errs, checkExtDependencies(ref, false, mod.Services[ref].ExtDependencies, deps)...)
+	}
+	for _, ref := range maputil.SortedKeys(mod.AuxServices) {
+		errs = append(errs, checkExtDependencies(ref, true, mod.AuxServices[ref].ExtDependencies, deps)...)
+	}
+	return errors.Join(errs...)
+}
+
+func checkExtDependencies(ref string, aux bool, targets map[string]module_lib.ExtDependencyTarget, deps map[string]module_lib.Module) []error {
+	var errs []error
+	for _, refVar := range maputil.SortedKeys(targets) {
+		target := targets[refVar]
+		if err := checkExtDependency(target, deps); err != nil {
+			errs = append(errs, &ExtDependencyError{Service: ref, Aux: aux, RefVar: refVar, Target: target, Err: err})
+		}
+	}
+	return errs
+}
+
+func checkExtDependency(target module_lib.ExtDependencyTarget, deps map[string]module_lib.Module) error {
+	dep, ok := deps[target.ID]
+	if !ok {
+		return ErrModuleNotProvided
+	}
+	return checkTarget(target, dep)
+}
+
+func checkTarget(target module_lib.ExtDependencyTarget, dep module_lib.Module) error {
+	srv, ok := dep.Services[target.Service]
+	if !ok {
+		if _, ok = dep.AuxServices[target.Service]; ok {
+			return ErrAuxService
+		}
+		return ErrServiceNotDefined
+	}
+	return checkTemplate(target.Template, srv.HttpEndpoints)
+}
+
+func checkTemplate(template string, endpoints map[string]module_lib.HttpEndpoint) error {
+	u, err := url.Parse(strings.ReplaceAll(template, "{ref}", "ref"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil
+	}
+	port := 80
+	if u.Scheme == "https" {
+		port = 443
+	}
+	if p := u.Port(); p != "" {
+		if port, err = strconv.Atoi(p); err != nil {
+			return nil
+		}
+	}
+	for _, ep := range endpoints {
+		if ep.Port == port && hasPathPrefix(u.Path, ep.Path) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: port %d path '%s'", ErrHttpEndpointMissing, port, u.Path)
+}
+
+func hasPathPrefix(p, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+	return p == prefix || strings.HasPrefix(p, prefix+"/")
+}
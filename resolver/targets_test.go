@@ -0,0 +1,83 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resolver
+
+import (
+	"errors"
+	"testing"
+
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func TestCheckExtDependencies(t *testing.T) {
+	db := testModule("db", "v1.0.0", nil, "postgres", "web")
+	db.Services["web"] = module_lib.Service{
+		HttpEndpoints: map[string]module_lib.HttpEndpoint{
+			"ui":  {Port: 80, Path: "/ui"},
+			"api": {Port: 8080},
+		},
+	}
+	db.AuxServices = map[string]module_lib.AuxService{"backup": {}}
+	deps := map[string]module_lib.Module{"db": db}
+	mod := testModule("app", "v1.0.0", map[string]string{"db": "v1.0.0"}, "api")
+	mod.Services["api"] = module_lib.Service{
+		ExtDependencies: map[string]module_lib.ExtDependencyTarget{
+			"A": {ID: "db", Service: "postgres"},
+			"B": {ID: "db", Service: "postgres", Template: "postgres://{ref}:5432"},
+			"C": {ID: "db", Service: "web", Template: "http://{ref}/ui/index.html"},
+			"D": {ID: "db", Service: "web", Template: "http://{ref}:8080/v1"},
+			"E": {ID: "db", Service: "web", Template: "{ref}:8080"},
+		},
+	}
+	if err := CheckExtDependencies(mod, deps); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	mod.AuxServices = map[string]module_lib.AuxService{
+		"worker": {
+			ExtDependencies: map[string]module_lib.ExtDependencyTarget{
+				"A": {ID: "db", Service: "backup"},
+				"B": {ID: "db", Service: "missing"},
+				"C": {ID: "db", Service: "web", Template: "http://{ref}/api"},
+				"D": {ID: "db", Service: "web", Template: "https://{ref}/ui"},
+				"E": {ID: "db", Service: "web", Template: "http://{ref}/uix"},
+				"F": {ID: "other", Service: "web"},
+			},
+		},
+	}
+	err := CheckExtDependencies(mod, deps)
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors")
+	}
+	errs := je.Unwrap()
+	a := []error{ErrAuxService, ErrServiceNotDefined, ErrHttpEndpointMissing, ErrHttpEndpointMissing, ErrHttpEndpointMissing, ErrModuleNotProvided}
+	if len(errs) != len(a) {
+		t.Fatalf("%d != %d", len(errs), len(a))
+	}
+	for i, e := range errs {
+		var edErr *ExtDependencyError
+		if !errors.As(e, &edErr) {
+			t.Errorf("%d: not *ExtDependencyError", i)
+		} else if !edErr.Aux || edErr.Service != "worker" {
+			t.Errorf("%d: %v %s", i, edErr.Aux, edErr.Service)
+		}
+		if !errors.Is(e, a[i]) {
+			t.Errorf("%d: %v != %v", i, e, a[i])
+		}
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type Operator string
+
+const (
+	Equal          Operator = "="
+	NotEqual       Operator = "!="
+	Greater        Operator = ">"
+	GreaterOrEqual Operator = ">="
+	Less           Operator = "<"
+	LessOrEqual    Operator = "<="
+)
+
+// operators is ordered so that two character operators are matched first.
+var operators = []Operator{GreaterOrEqual, LessOrEqual, NotEqual, Equal, Greater, Less}
+
+// Comparison compares a version with Version using Operator.
+type Comparison struct {
+	Operator Operator
+	Version  Version
+}
+
+func (c Comparison) String() string {
+	return string(c.Operator) + c.Version.String()
+}
+
+// Check reports whether v satisfies the comparison.
+func (c Comparison) Check(v Version) bool {
+	r := v.Compare(c.Version)
+	switch c.Operator {
+	case Equal:
+		return r == 0
+	case NotEqual:
+		return r != 0
+	case Greater:
+		return r > 0
+	case GreaterOrEqual:
+		return r >= 0
+	case Less:
+		return r < 0
+	case LessOrEqual:
+		return r <= 0
+	}
+	return false
+}
+
+// Constraint is a list of comparisons that all must be satisfied.
+type Constraint []Comparison
+
+// ParseConstraint parses constraints of the form '>=v1.0.2;<v2.1.3'. Comparisons are separated by ';' and
+// consist of an operator (=, !=, >, >=, <, <=) and a version. A version without operator is treated as '='.
+func ParseConstraint(s string) (Constraint, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, errors.New("empty constraint")
+	}
+	var c Constraint
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		op := Equal
+		for _, o := range operators {
+			if strings.HasPrefix(part, string(o)) {
+				op = o
+				part = strings.TrimSpace(part[len(o):])
+				break
+			}
+		}
+		v, err := ParseVersion(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid constraint '%s': %w", s, err)
+		}
+		c = append(c, Comparison{Operator: op, Version: v})
+	}
+	return c, nil
+}
+
+func (c Constraint) String() string {
+	parts := make([]string, len(c))
+	for i, cmp := range c {
+		parts[i] = cmp.String()
+	}
+	return strings.Join(parts, ";")
+}
+
+// Check reports whether v satisfies all comparisons.
+func (c Constraint) Check(v Version) bool {
+	for _, cmp := range c {
+		if !cmp.Check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Satisfies parses constraint and version and reports whether the version satisfies the constraint.
+func Satisfies(constraint, version string) (bool, error) {
+	c, err := ParseConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+	v, err := ParseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	return c.Check(v), nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseConstraint(t *testing.T) {
+	if c, err := ParseConstraint(">=v1.0.2; <v2.1.3"); err != nil {
+		t.Error(err)
+	} else if len(c) != 2 || c[0].Operator != GreaterOrEqual || c[1].Operator != Less {
+		t.Errorf("%+v", c)
+	} else if c.String() != ">=v1.0.2;<v2.1.3" {
+		t.Errorf("%s != >=v1.0.2;<v2.1.3", c)
+	}
+	// ---------------------------
+	if c, err := ParseConstraint("v1.0.0"); err != nil {
+		t.Error(err)
+	} else if len(c) != 1 || c[0].Operator != Equal {
+		t.Errorf("%+v", c)
+	}
+	// ---------------------------
+	for _, s := range []string{"", ";", ">=", ">=v1.0.2;", "~v1.0.0", "=>v1.0.0", ">1.0.0"} {
+		if _, err := ParseConstraint(s); err == nil {
+			t.Errorf("%s: err == nil", s)
+		}
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		ok         bool
+	}{
+		{"=v1.0.2", "v1.0.2", true},
+		{"=v1.0.2", "v1.0.3", false},
+		{"!=v1.0.2", "v1.0.3", true},
+		{">v1.0.2", "v1.0.2", false},
+		{">v1.0.2", "v1.0.10", true},
+		{">=v1.0.2", "v1.0.2", true},
+		{"<v2.0.0", "v2.0.0-rc.1", true},
+		{"<=v2.0.0", "v2.0.0+build", true},
+		{">v1.0.2;<v2.1.3", "v2.0.0", true},
+		{">v1.0.2;<v2.1.3", "v2.1.3", false},
+		{">v1.0.2;<v2.1.3", "v1.0.1", false},
+	}
+	for _, tc := range tests {
+		if ok, err := Satisfies(tc.constraint, tc.version); err != nil {
+			t.Error(err)
+		} else if ok != tc.ok {
+			t.Errorf("%s %s: %v != %v", tc.constraint, tc.version, ok, tc.ok)
+		}
+	}
+	// ---------------------------
+	if _, err := Satisfies(">v1.0.0", "1.0.0"); err == nil {
+		t.Error("err == nil")
+	}
+	if _, err := Satisfies(">1.0.0", "v1.0.0"); err == nil {
+		t.Error("err == nil")
+	}
+}
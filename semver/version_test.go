@@ -0,0 +1,75 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package semver
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	a := Version{Major: 1, Minor: 2, Patch: 3, PreRelease: []string{"rc", "1"}, Build: []string{"build", "001"}}
+	if b, err := ParseVersion("v1.2.3-rc.1+build.001"); err != nil {
+		t.Error(err)
+	} else if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	} else if b.String() != "v1.2.3-rc.1+build.001" {
+		t.Errorf("%s != v1.2.3-rc.1+build.001", b)
+	}
+	// ---------------------------
+	if b, err := ParseVersion("v1.0.0-x-y"); err != nil {
+		t.Error(err)
+	} else if !slices.Equal(b.PreRelease, []string{"x-y"}) {
+		t.Errorf("%v != [x-y]", b.PreRelease)
+	}
+	// ---------------------------
+	for _, s := range []string{"", "1.0.0", "v1.0", "v1.0.0.0", "v01.0.0", "v1.0.0-", "v1.0.0-01", "v1.0.0-a..b", "v1.0.0+", "v1.0.0-a_b", "va.b.c", "v-1.0.0"} {
+		if _, err := ParseVersion(s); err == nil {
+			t.Errorf("%s: err == nil", s)
+		}
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	ordered := []string{
+		"v1.0.0-alpha",
+		"v1.0.0-alpha.1",
+		"v1.0.0-alpha.beta",
+		"v1.0.0-beta",
+		"v1.0.0-beta.2",
+		"v1.0.0-beta.11",
+		"v1.0.0-rc.1",
+		"v1.0.0",
+		"v1.0.1",
+		"v1.1.0",
+		"v2.0.0",
+		"v10.0.0",
+	}
+	for i := 0; i < len(ordered)-1; i++ {
+		a, _ := ParseVersion(ordered[i])
+		b, _ := ParseVersion(ordered[i+1])
+		if a.Compare(b) != -1 || b.Compare(a) != 1 {
+			t.Errorf("%s !< %s", a, b)
+		}
+	}
+	a, _ := ParseVersion("v1.0.0+a")
+	b, _ := ParseVersion("v1.0.0+b")
+	if a.Compare(b) != 0 {
+		t.Errorf("%s != %s", a, b)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/platform"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+// GenArchitectures maps the modfile architectures to canonical platform identifiers (e.g. linux/arm/v7), see platform.Parse.
+func GenArchitectures(architectures []string) (map[module_lib.CPUArch]struct{}, error) {
+	if len(architectures) == 0 {
+		return nil, nil
+	}
+	var errs []error
+	set := make(map[module_lib.CPUArch]struct{})
+	for i, arch := range architectures {
+		p, err := platform.Parse(arch)
+		if err != nil {
+			errs = append(errs, model.NewError(model.Path{"architectures", i}, err))
+			continue
+		}
+		set[p.String()] = struct{}{}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return set, nil
+}
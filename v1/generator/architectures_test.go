@@ -0,0 +1,55 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func TestGenArchitectures(t *testing.T) {
+	if set, err := GenArchitectures(nil); err != nil || set != nil {
+		t.Errorf("%v %v", set, err)
+	}
+	// ---------------------------
+	set, err := GenArchitectures([]string{"x86_64", "amd64", "aarch32", "arm64v8", "i386"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := map[module_lib.CPUArch]struct{}{
+		"linux/amd64":  {},
+		"linux/arm/v7": {},
+		"linux/arm64":  {},
+		"linux/386":    {},
+	}
+	if !reflect.DeepEqual(a, set) {
+		t.Errorf("%v != %v", a, set)
+	}
+	// ---------------------------
+	_, err = GenArchitectures([]string{"amd64", "sparc"})
+	var mErr *model.Error
+	if !errors.As(err, &mErr) {
+		t.Fatal("not *Error")
+	}
+	if mErr.Path.String() != "architectures[1]" {
+		t.Error(mErr.Path.String())
+	}
+}
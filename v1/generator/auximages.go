@@ -0,0 +1,45 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/imageref"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+)
+
+// GenAuxImageSources validates the aux image source patterns, see imageref.ParsePattern.
+// Patterns are kept as declared, use imageref.AuxImageAllowed for matching images.
+func GenAuxImageSources(sources []string) (map[string]struct{}, error) {
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	var errs []error
+	set := make(map[string]struct{})
+	for i, src := range sources {
+		if _, err := imageref.ParsePattern(src); err != nil {
+			errs = append(errs, model.NewError(model.Path{"auxImageSources", i}, err))
+			continue
+		}
+		set[src] = struct{}{}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return set, nil
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+)
+
+func TestGenAuxImageSources(t *testing.T) {
+	if set, err := GenAuxImageSources(nil); err != nil || set != nil {
+		t.Errorf("%v %v", set, err)
+	}
+	// ---------------------------
+	set, err := GenAuxImageSources([]string{"ghcr.io/senergy-platform/*", "senergy/**"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := map[string]struct{}{
+		"ghcr.io/senergy-platform/*": {},
+		"senergy/**":                 {},
+	}
+	if !reflect.DeepEqual(a, set) {
+		t.Errorf("%v != %v", a, set)
+	}
+	// ---------------------------
+	_, err = GenAuxImageSources([]string{"ghcr.io/senergy-platform/*", "ghcr.io/a**"})
+	var mErr *model.Error
+	if !errors.As(err, &mErr) {
+		t.Fatal("not *Error")
+	}
+	if mErr.Path.String() != "auxImageSources[1]" {
+		t.Error(mErr.Path.String())
+	}
+}
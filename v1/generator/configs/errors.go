@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configs
+
+import "fmt"
+
+// TypeMismatchError indicates that a config value or option does not match the declared data type.
+type TypeMismatchError struct {
+	Ref      string // config reference
+	DataType string // expected data type, "slice" if a list was expected
+	Value    any    // offending value
+}
+
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("type mismatch: %T != %s", e.Value, e.DataType)
+}
+
+// UnknownDataTypeError indicates that the declared data type of a config is not supported.
+type UnknownDataTypeError struct {
+	Ref      string
+	DataType string
+}
+
+func (e *UnknownDataTypeError) Error() string {
+	return fmt.Sprintf("%s invalid data type '%s'", e.Ref, e.DataType)
+}
@@ -17,6 +17,9 @@
 package configs
 
 import (
+	"errors"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
 	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
 	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
 )
@@ -26,16 +29,21 @@ func GenConfigs(mfCVs map[string]model.ConfigValue) (module_lib.Configs, error)
 		return nil, nil
 	}
 	mCs := make(module_lib.Configs)
-	for ref, mfCV := range mfCVs {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mfCVs) {
+		mfCV := mfCVs[ref]
 		if mfCV.IsList {
 			if err := SetSlice(ref, mfCV, mCs); err != nil {
-				return nil, err
+				errs = append(errs, err)
 			}
 		} else {
 			if err := SetValue(ref, mfCV, mCs); err != nil {
-				return nil, err
+				errs = append(errs, err)
 			}
 		}
 	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
 	return mCs, nil
 }
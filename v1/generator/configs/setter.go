@@ -17,7 +17,9 @@
 package configs
 
 import (
+	"errors"
 	"fmt"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
 	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
 	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
 	"strconv"
@@ -43,29 +45,29 @@ func SetSlice(ref string, mfCV model.ConfigValue, mCs module_lib.Configs) error
 	case module_lib.StringType:
 		d, o, co, err := parseConfigSlice(mfCV.Value, mfCV.Options, cTypeOption, parseConfigValueString)
 		if err != nil {
-			return fmt.Errorf("error parsing config '%s': %s", ref, err)
+			return newParseError(ref, err)
 		}
 		mCs.SetStringSlice(ref, d, o, mfCV.OptionsExt, configType, co, delimiter, !mfCV.Optional)
 	case module_lib.BoolType:
 		d, o, co, err := parseConfigSlice(mfCV.Value, mfCV.Options, cTypeOption, parseConfigValueBool)
 		if err != nil {
-			return fmt.Errorf("error parsing config '%s': %s", ref, err)
+			return newParseError(ref, err)
 		}
 		mCs.SetBoolSlice(ref, d, o, mfCV.OptionsExt, configType, co, delimiter, !mfCV.Optional)
 	case module_lib.Int64Type:
 		d, o, co, err := parseConfigSlice(mfCV.Value, mfCV.Options, cTypeOption, parseConfigValueInt64)
 		if err != nil {
-			return fmt.Errorf("error parsing config '%s': %s", ref, err)
+			return newParseError(ref, err)
 		}
 		mCs.SetInt64Slice(ref, d, o, mfCV.OptionsExt, configType, co, delimiter, !mfCV.Optional)
 	case module_lib.Float64Type:
 		d, o, co, err := parseConfigSlice(mfCV.Value, mfCV.Options, cTypeOption, parseConfigValueFloat64)
 		if err != nil {
-			return fmt.Errorf("error parsing config '%s': %s", ref, err)
+			return newParseError(ref, err)
 		}
 		mCs.SetFloat64Slice(ref, d, o, mfCV.OptionsExt, configType, co, delimiter, !mfCV.Optional)
 	default:
-		return fmt.Errorf("%s invalid data type '%s'", ref, dataType)
+		return model.NewError(model.Path{"configs", ref, "dataType"}, &UnknownDataTypeError{Ref: ref, DataType: dataType})
 	}
 	return nil
 }
@@ -85,33 +87,41 @@ func SetValue(ref string, mfCV model.ConfigValue, mCs module_lib.Configs) error
 	case module_lib.StringType:
 		d, o, co, err := parseConfig(mfCV.Value, mfCV.Options, cTypeOption, parseConfigValueString)
 		if err != nil {
-			return fmt.Errorf("error parsing config '%s': %s", ref, err)
+			return newParseError(ref, err)
 		}
 		mCs.SetString(ref, d, o, mfCV.OptionsExt, configType, co, !mfCV.Optional)
 	case module_lib.BoolType:
 		d, o, co, err := parseConfig(mfCV.Value, mfCV.Options, cTypeOption, parseConfigValueBool)
 		if err != nil {
-			return fmt.Errorf("error parsing config '%s': %s", ref, err)
+			return newParseError(ref, err)
 		}
 		mCs.SetBool(ref, d, o, mfCV.OptionsExt, configType, co, !mfCV.Optional)
 	case module_lib.Int64Type:
 		d, o, co, err := parseConfig(mfCV.Value, mfCV.Options, cTypeOption, parseConfigValueInt64)
 		if err != nil {
-			return fmt.Errorf("error parsing config '%s': %s", ref, err)
+			return newParseError(ref, err)
 		}
 		mCs.SetInt64(ref, d, o, mfCV.OptionsExt, configType, co, !mfCV.Optional)
 	case module_lib.Float64Type:
 		d, o, co, err := parseConfig(mfCV.Value, mfCV.Options, cTypeOption, parseConfigValueFloat64)
 		if err != nil {
-			return fmt.Errorf("error parsing config '%s': %s", ref, err)
+			return newParseError(ref, err)
 		}
 		mCs.SetFloat64(ref, d, o, mfCV.OptionsExt, configType, co, !mfCV.Optional)
 	default:
-		return fmt.Errorf("%s invalid data type '%s'", ref, dataType)
+		return model.NewError(model.Path{"configs", ref, "dataType"}, &UnknownDataTypeError{Ref: ref, DataType: dataType})
 	}
 	return nil
 }
 
+func newParseError(ref string, err error) error {
+	var tmErr *TypeMismatchError
+	if errors.As(err, &tmErr) {
+		tmErr.Ref = ref
+	}
+	return model.NewError(model.Path{"configs", ref}, fmt.Errorf("error parsing config '%s': %w", ref, err))
+}
+
 func parseConfig[T any](val any, opt []any, ctOpt map[string]any, valParser func(any) (T, error)) (p *T, o []T, to module_lib.ConfigTypeOptions, err error) {
 	if val != nil {
 		v, er := valParser(val)
@@ -132,7 +142,7 @@ func parseConfigSlice[T any](val any, opt []any, ctOpt map[string]any, valParser
 	if val != nil {
 		v, ok := val.([]any)
 		if !ok {
-			err = fmt.Errorf("type missmatch: %T != slice", val)
+			err = &TypeMismatchError{DataType: "slice", Value: val}
 			return
 		}
 		for _, i := range v {
@@ -183,7 +193,7 @@ func parseConfigValueString(val any) (string, error) {
 	case int64:
 		sVal = strconv.FormatInt(v, 10)
 	default:
-		return "", fmt.Errorf("invalid data type '%T'", val)
+		return "", &TypeMismatchError{DataType: module_lib.StringType, Value: val}
 	}
 	return sVal, nil
 }
@@ -191,7 +201,7 @@ func parseConfigValueString(val any) (string, error) {
 func parseConfigValueBool(val any) (bool, error) {
 	v, ok := val.(bool)
 	if !ok {
-		return false, fmt.Errorf("invalid data type '%T'", val)
+		return false, &TypeMismatchError{DataType: module_lib.BoolType, Value: val}
 	}
 	return v, nil
 }
@@ -210,7 +220,7 @@ func parseConfigValueInt64(val any) (int64, error) {
 	case int64:
 		i = v
 	default:
-		return i, fmt.Errorf("invalid data type '%T'", val)
+		return i, &TypeMismatchError{DataType: module_lib.Int64Type, Value: val}
 	}
 	return i, nil
 }
@@ -223,14 +233,15 @@ func parseConfigValueFloat64(val any) (float64, error) {
 	case float64:
 		f = v
 	default:
-		return f, fmt.Errorf("invalid data type '%T'", val)
+		return f, &TypeMismatchError{DataType: module_lib.Float64Type, Value: val}
 	}
 	return f, nil
 }
 
 func parseConfigTypeOptions(opt map[string]any) (module_lib.ConfigTypeOptions, error) {
 	o := make(module_lib.ConfigTypeOptions)
-	for key, val := range opt {
+	for _, key := range maputil.SortedKeys(opt) {
+		val := opt[key]
 		switch v := val.(type) {
 		case string:
 			o.SetString(key, v)
@@ -438,6 +438,22 @@ func TestSetValue(t *testing.T) {
 	} else if len(mCs) == 0 {
 		t.Errorf("len(%v) == 0", mCs)
 	}
+	// ---------------------------
+	dataType := module_lib.Int64Type
+	var tmErr *TypeMismatchError
+	if err := SetValue("a", model.ConfigValue{Value: "test", DataType: &dataType}, mCs); !errors.As(err, &tmErr) {
+		t.Error("not *TypeMismatchError")
+	} else if tmErr.Ref != "a" || tmErr.DataType != dataType || tmErr.Value != "test" {
+		t.Errorf("%+v", tmErr)
+	}
+	// ---------------------------
+	dataType = "test"
+	var udtErr *UnknownDataTypeError
+	if err := SetValue("a", model.ConfigValue{DataType: &dataType}, mCs); !errors.As(err, &udtErr) {
+		t.Error("not *UnknownDataTypeError")
+	} else if udtErr.Ref != "a" || udtErr.DataType != dataType {
+		t.Errorf("%+v", udtErr)
+	}
 }
 
 func TestSetValueStr(t *testing.T) {
@@ -17,6 +17,9 @@
 package generator
 
 import (
+	"errors"
+	"io/fs"
+
 	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/configs"
 	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/generic"
 	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/inputs"
@@ -27,75 +30,157 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// Options configures the decoding and generation of modules.
+type Options struct {
+	// Strict causes fields not defined by the modfile model to be reported as errors.
+	Strict bool
+	// Warn, if set, receives problems that do not cause the decoding to fail, e.g. unknown fields if Strict is false.
+	Warn func(error)
+	// FS provides access to the module repository, e.g. for resolving includes (see model.ResolveIncludes) and
+	// checking default file content (see mounts.CheckFiles).
+	FS fs.FS
+	// Vars enables the expansion of variable references using the provided values, see model.Interpolate.
+	// Interpolation is disabled if nil.
+	Vars map[string]string
+	// MaxNodes limits the number of nodes with aliases expanded, see model.CheckLimits. Zero disables the limit.
+	MaxNodes int
+	// MaxDepth limits the nesting depth with aliases expanded, see model.CheckLimits. Zero disables the limit.
+	MaxDepth int
+	// MaxPorts limits the number of ports of all services with ranges expanded. Zero disables the limit.
+	MaxPorts int
+	// MaxSize limits the size of each file read from FS in bytes, i.e. included files and default file content.
+	// Zero disables the limit.
+	MaxSize int64
+	// MaxIncludeSize limits the total number of bytes read from included files. Zero disables the limit.
+	MaxIncludeSize int64
+	// MaxIncludes limits the number of resolved includes. Zero disables the limit.
+	MaxIncludes int
+	// MutableTags lists image tags that are rejected unless the image is pinned by digest. If nil,
+	// imageref.DefaultMutableTags are rejected, an empty slice accepts all tags.
+	MutableTags []string
+	// FileSchemas, if not nil, receives the schema path of each file declaring one, keyed by file reference.
+	// Generated modules do not carry schema paths, use them to validate content provided by users, see
+	// validation.ValidateContent.
+	FileSchemas map[string]string
+	// IncludedFiles, if not nil, receives the repository path of each file included by the modfile, see
+	// model.ResolveIncludes.
+	IncludedFiles map[string]struct{}
+}
+
+// GetModule decodes and generates a module. Generation does not stop at the first problem, all errors are
+// returned joined (see errors.Join) and located in yn, see model.Locate.
 func GetModule(yn *yaml.Node) (module_lib.Module, error) {
-	var mf model.ModFile
-	err := yn.Decode(&mf)
-	if err != nil {
-		return module_lib.Module{}, err
-	}
-	return generateModule(mf)
+	return GetModuleWithOptions(yn, Options{})
+}
+
+// GetModuleWithOptions is like GetModule but applies the provided options.
+func GetModuleWithOptions(yn *yaml.Node, opt Options) (module_lib.Module, error) {
+	return pipeline.Run(yn, opt)
+}
+
+// Generate decodes and generates a module from a modfile document prepared by Prepare, see Pipeline.Generate.
+func Generate(yn *yaml.Node, inc model.Includes, opt Options) (module_lib.Module, error) {
+	return pipeline.Generate(yn, inc, opt)
+}
+
+// GenerateJSON is like Generate but decodes the document with encoding/json, see model.DecodeJSON. Used for JSON
+// modfiles, so that they are decoded with the UnmarshalJSON methods of the model.
+func GenerateJSON(yn *yaml.Node, inc model.Includes, opt Options) (module_lib.Module, error) {
+	return jsonPipeline.Generate(yn, inc, opt)
+}
+
+var pipeline = Pipeline{
+	Model: model.ModFile{},
+	Decode: func(yn *yaml.Node) (Modfile, error) {
+		var mf model.ModFile
+		err := yn.Decode(&mf)
+		return modfile{mf: mf}, err
+	},
+}
+
+var jsonPipeline = Pipeline{
+	Model: model.ModFile{},
+	Decode: func(yn *yaml.Node) (Modfile, error) {
+		var mf model.ModFile
+		err := model.DecodeJSON(yn, &mf)
+		return modfile{mf: mf}, err
+	},
+}
+
+type modfile struct {
+	mf model.ModFile
 }
 
+func (m modfile) Generate() (module_lib.Module, error) {
+	return generateModule(m.mf)
+}
+
+func (m modfile) Files() map[string]model.File {
+	return m.mf.Files
+}
+
+// generateModule runs all generators and setters and joins their errors in a deterministic order. The module is
+// returned even if errors occur.
 func generateModule(mf model.ModFile) (module_lib.Module, error) {
+	var errs []error
 	mCs, err := configs.GenConfigs(mf.Configs)
 	if err != nil {
-		return module_lib.Module{}, err
+		errs = append(errs, err)
 	}
 	mSs, err := services.GenServices(mf.Services)
 	if err != nil {
-		return module_lib.Module{}, err
+		errs = append(errs, err)
 	}
 	mAs, err := services.GenAuxServices(mf.AuxServices)
 	if err != nil {
-		return module_lib.Module{}, err
+		errs = append(errs, err)
 	}
-	err = services.SetSrvReferences(mf.ServiceReferences, mSs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetSrvReferences(mf.ServiceReferences, mSs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetAuxSrvReferences(mf.ServiceReferences, mAs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetAuxSrvReferences(mf.ServiceReferences, mAs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetVolumes(mf.Volumes, mSs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetVolumes(mf.Volumes, mSs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetAuxVolumes(mf.Volumes, mAs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetAuxVolumes(mf.Volumes, mAs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetExtDependencies(mf.Dependencies, mSs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetExtDependencies(mf.Dependencies, mSs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetAuxExtDependencies(mf.Dependencies, mAs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetAuxExtDependencies(mf.Dependencies, mAs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetHostResources(mf.HostResources, mSs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetHostResources(mf.HostResources, mSs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetFiles(mf.Files, mSs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetFiles(mf.Files, mSs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetFileGroups(mf.FileGroups, mSs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetFileGroups(mf.FileGroups, mSs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetSecrets(mf.Secrets, mSs)
-	if err != nil {
-		return module_lib.Module{}, err
+	if err = services.SetSecrets(mf.Secrets, mSs); err != nil {
+		errs = append(errs, err)
+	}
+	if err = services.SetConfigs(mf.Configs, mSs); err != nil {
+		errs = append(errs, err)
+	}
+	if err = services.SetAuxConfigs(mf.Configs, mAs); err != nil {
+		errs = append(errs, err)
+	}
+	if err = services.CheckMountPoints(mSs, mAs); err != nil {
+		errs = append(errs, err)
 	}
-	err = services.SetConfigs(mf.Configs, mSs)
+	architectures, err := GenArchitectures(mf.Architectures)
 	if err != nil {
-		return module_lib.Module{}, err
+		errs = append(errs, err)
 	}
-	err = services.SetAuxConfigs(mf.Configs, mAs)
+	auxImgSrc, err := GenAuxImageSources(mf.AuxImageSources)
 	if err != nil {
-		return module_lib.Module{}, err
+		errs = append(errs, err)
 	}
 	return module_lib.Module{
 		ID:            mf.ID,
@@ -105,7 +190,7 @@ func generateModule(mf model.ModFile) (module_lib.Module, error) {
 		License:       mf.License,
 		Author:        mf.Author,
 		Version:       mf.Version,
-		Architectures: generic.GenStringSet(mf.Architectures),
+		Architectures: architectures,
 		Services:      mSs,
 		Volumes:       mounts.GenVolumes(mf.Volumes),
 		Dependencies:  mounts.GenDependencies(mf.Dependencies),
@@ -123,6 +208,6 @@ func generateModule(mf model.ModFile) (module_lib.Module, error) {
 			Groups:     inputs.GenInputGroups(mf.InputGroups),
 		},
 		AuxServices: mAs,
-		AuxImgSrc:   generic.GenStringSet(mf.AuxImageSources),
-	}, nil
+		AuxImgSrc:   auxImgSrc,
+	}, errors.Join(errs...)
 }
@@ -41,10 +41,10 @@ func TestGenerator(t *testing.T) {
 		License:       "lcs",
 		Author:        "ath",
 		Version:       "ver",
-		Architectures: []string{"arch"},
+		Architectures: []string{"x86_64"},
 		Services: map[string]model.Service{
-			sA: {},
-			sB: {},
+			sA: {Image: "a:v1"},
+			sB: {Image: "b:v1"},
 		},
 		AuxServices: map[string]model.AuxService{
 			aA: {},
@@ -179,9 +179,10 @@ func TestGenerator(t *testing.T) {
 		License:       "lcs",
 		Author:        "ath",
 		Version:       "ver",
-		Architectures: map[module_lib.CPUArch]struct{}{"arch": {}},
+		Architectures: map[module_lib.CPUArch]struct{}{"linux/amd64": {}},
 		Services: map[string]module_lib.Service{
 			sA: {
+				Image: "a:v1",
 				RunConfig: module_lib.RunConfig{
 					StopTimeout: 5 * time.Second,
 					StopSignal:  "",
@@ -217,6 +218,7 @@ func TestGenerator(t *testing.T) {
 				Ports: nil,
 			},
 			sB: {
+				Image: "b:v1",
 				RunConfig: module_lib.RunConfig{
 					StopTimeout: 5 * time.Second,
 					StopSignal:  "",
@@ -454,3 +456,48 @@ func TestGenerator(t *testing.T) {
 		t.Error("err == nil")
 	}
 }
+
+func TestGeneratorErrors(t *testing.T) {
+	strType := module_lib.StringType
+	mf := model.ModFile{
+		Services: map[string]model.Service{
+			"a": {
+				Image: "img:v1",
+				Ports: []model.SrvPort{{Port: "81-80"}},
+			},
+		},
+		Volumes: map[string][]model.VolumeTarget{
+			"vol": {{MountPoint: "mnt", Services: []string{"a", "b"}}},
+		},
+		Configs: map[string]model.ConfigValue{
+			"cfg": {
+				DataType: &strType,
+				Value:    true,
+				Targets:  []model.ConfigTarget{{RefVar: "var", Services: []string{"c"}}},
+			},
+		},
+	}
+	_, err := generateModule(mf)
+	if err == nil {
+		t.Fatal("err == nil")
+	}
+	if l := len(flattenErrors(err)); l != 4 {
+		t.Errorf("%d != 4", l)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err2 := generateModule(mf); err2.Error() != err.Error() {
+			t.Fatalf("%s != %s", err2, err)
+		}
+	}
+}
+
+func flattenErrors(err error) []error {
+	if e, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+		for _, er := range e.Unwrap() {
+			errs = append(errs, flattenErrors(er)...)
+		}
+		return errs
+	}
+	return []error{err}
+}
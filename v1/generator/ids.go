@@ -0,0 +1,94 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/moduleid"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+// CanonicalID validates the module ID and returns its canonical form, see moduleid.Canonical.
+func CanonicalID(id string) (string, error) {
+	c, err := moduleid.Canonical(id)
+	if err != nil {
+		return id, model.NewError(model.Path{"id"}, err)
+	}
+	return c, nil
+}
+
+// CanonicalDependencies returns the dependencies keyed by canonical module IDs. Invalid IDs are kept as declared,
+// IDs that become duplicates are reported as errors.
+func CanonicalDependencies[T any](dependencies map[string]T) (map[string]T, error) {
+	if len(dependencies) == 0 {
+		return dependencies, nil
+	}
+	var errs []error
+	deps := make(map[string]T)
+	keys := make([]string, 0, len(dependencies))
+	for id := range dependencies {
+		keys = append(keys, id)
+	}
+	slices.Sort(keys)
+	for _, id := range keys {
+		c, err := moduleid.Canonical(id)
+		if err != nil {
+			errs = append(errs, model.NewError(model.Path{"dependencies", id}, err))
+			c = id
+		} else if _, ok := deps[c]; ok {
+			errs = append(errs, model.NewError(model.Path{"dependencies", id}, fmt.Errorf("duplicate module ID '%s'", c)))
+			continue
+		}
+		deps[c] = dependencies[id]
+	}
+	return deps, errors.Join(errs...)
+}
+
+// CanonicalModule sets the module ID, the dependency keys and the module IDs of external dependencies to their
+// canonical form. The module is expected to be generated with IDs as declared, so that errors of the generation
+// and of this function refer to the declared IDs and can be located.
+func CanonicalModule(mod *module_lib.Module) error {
+	var errs []error
+	var err error
+	if mod.ID, err = CanonicalID(mod.ID); err != nil {
+		errs = append(errs, err)
+	}
+	if mod.Dependencies, err = CanonicalDependencies(mod.Dependencies); err != nil {
+		errs = append(errs, err)
+	}
+	for _, mS := range mod.Services {
+		canonicalExtDependencies(mS.ExtDependencies)
+	}
+	for _, mA := range mod.AuxServices {
+		canonicalExtDependencies(mA.ExtDependencies)
+	}
+	return errors.Join(errs...)
+}
+
+// canonicalExtDependencies keeps invalid IDs, they are reported as undefined dependencies by the generators.
+func canonicalExtDependencies(extDeps map[string]module_lib.ExtDependencyTarget) {
+	for refVar, extDep := range extDeps {
+		if id, err := moduleid.Canonical(extDep.ID); err == nil {
+			extDep.ID = id
+			extDeps[refVar] = extDep
+		}
+	}
+}
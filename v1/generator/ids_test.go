@@ -0,0 +1,77 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+)
+
+func TestCanonicalID(t *testing.T) {
+	if id, err := CanonicalID("https://GitHub.com/user/repo/"); err != nil {
+		t.Error(err)
+	} else if id != "github.com/user/repo" {
+		t.Error(id)
+	}
+	_, err := CanonicalID("user repo")
+	var mErr *model.Error
+	if !errors.As(err, &mErr) {
+		t.Fatal("not *Error")
+	}
+	if mErr.Path.String() != "id" {
+		t.Error(mErr.Path.String())
+	}
+}
+
+func TestCanonicalDependencies(t *testing.T) {
+	deps, err := CanonicalDependencies(map[string]string{
+		"GitHub.com/user/a/": "v1.0.0",
+		"github.com/user/b":  "v2.0.0",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deps) != 2 || deps["github.com/user/a"] != "v1.0.0" || deps["github.com/user/b"] != "v2.0.0" {
+		t.Errorf("%v", deps)
+	}
+	// ---------------------------
+	deps, err = CanonicalDependencies(map[string]string{
+		"github.com/user/a":   "v1.0.0",
+		"github.com/user/a/":  "v2.0.0",
+		"github.com/user a/b": "v3.0.0",
+	})
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors")
+	}
+	paths := make(map[string]bool)
+	for _, e := range je.Unwrap() {
+		var mErr *model.Error
+		if !errors.As(e, &mErr) {
+			t.Fatal("not *Error")
+		}
+		paths[mErr.Path.String()] = true
+	}
+	if len(paths) != 2 || !paths[`dependencies["github.com/user/a/"]`] || !paths[`dependencies["github.com/user a/b"]`] {
+		t.Errorf("%v", paths)
+	}
+	if deps["github.com/user/a"] != "v1.0.0" {
+		t.Errorf("%v", deps)
+	}
+}
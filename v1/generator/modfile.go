@@ -0,0 +1,663 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"cmp"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/platform"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+// GetModFile is the inverse of GetModule. Per service mappings (volumes, configs, references, ...) are folded back
+// into the top-level sections of the modfile, generating the module from the result yields an equal module.
+func GetModFile(mod module_lib.Module) (model.ModFile, error) {
+	mf := model.ModFile{
+		ModfileVersion:  model.Version,
+		ID:              mod.ID,
+		Name:            mod.Name,
+		Description:     mod.Description,
+		Tags:            maputil.SortedKeys(mod.Tags),
+		License:         mod.License,
+		Author:          mod.Author,
+		Version:         mod.Version,
+		Architectures:   genModFileArchitectures(mod.Architectures),
+		AuxImageSources: maputil.SortedKeys(mod.AuxImgSrc),
+		InputGroups:     genModFileInputGroups(mod.Inputs.Groups),
+	}
+	var err error
+	if mf.Services, err = genModFileServices(mod.Services); err != nil {
+		return model.ModFile{}, err
+	}
+	mf.AuxServices = genModFileAuxServices(mod.AuxServices)
+	mf.ServiceReferences = genModFileSrvReferences(mod.Services, mod.AuxServices)
+	if mf.Volumes, err = genModFileVolumes(mod.Volumes, mod.Services, mod.AuxServices); err != nil {
+		return model.ModFile{}, err
+	}
+	if mf.Dependencies, err = genModFileDependencies(mod.Dependencies, mod.Services, mod.AuxServices); err != nil {
+		return model.ModFile{}, err
+	}
+	if mf.HostResources, err = genModFileHostResources(mod.HostResources, mod.Inputs.Resources, mod.Services); err != nil {
+		return model.ModFile{}, err
+	}
+	if mf.Secrets, err = genModFileSecrets(mod.Secrets, mod.Inputs.Secrets, mod.Services); err != nil {
+		return model.ModFile{}, err
+	}
+	if mf.Configs, err = genModFileConfigs(mod.Configs, mod.Inputs.Configs, mod.Services, mod.AuxServices); err != nil {
+		return model.ModFile{}, err
+	}
+	if mf.Files, err = genModFileFiles(mod.Files, mod.Inputs.Files, mod.Services); err != nil {
+		return model.ModFile{}, err
+	}
+	if mf.FileGroups, err = genModFileFileGroups(mod.FileGroups, mod.Inputs.FileGroups, mod.Services); err != nil {
+		return model.ModFile{}, err
+	}
+	return mf, nil
+}
+
+func genModFileServices(mSs map[string]module_lib.Service) (map[string]model.Service, error) {
+	if len(mSs) == 0 {
+		return nil, nil
+	}
+	mfSs := make(map[string]model.Service)
+	for ref, mS := range mSs {
+		mfPs, err := genModFilePorts(mS.Ports)
+		if err != nil {
+			return nil, fmt.Errorf("service '%s' invalid port mapping: %s", ref, err)
+		}
+		mfSs[ref] = model.Service{
+			Name:              mS.Name,
+			Image:             mS.Image,
+			RunConfig:         genModFileRunConfig(mS.RunConfig),
+			Include:           genModFileBindMounts(mS.BindMounts),
+			Tmpfs:             genModFileTmpfsMounts(mS.Tmpfs),
+			HttpEndpoints:     genModFileHttpEndpoints(mS.HttpEndpoints),
+			Ports:             mfPs,
+			DeviceCGroupRules: mS.DeviceCGroupRules,
+		}
+	}
+	return mfSs, nil
+}
+
+func genModFileAuxServices(mAs map[string]module_lib.AuxService) map[string]model.AuxService {
+	if len(mAs) == 0 {
+		return nil
+	}
+	mfAs := make(map[string]model.AuxService)
+	for ref, mA := range mAs {
+		mfAs[ref] = model.AuxService{
+			Name:      mA.Name,
+			RunConfig: genModFileRunConfig(mA.RunConfig),
+			Include:   genModFileBindMounts(mA.BindMounts),
+			Tmpfs:     genModFileTmpfsMounts(mA.Tmpfs),
+		}
+	}
+	return mfAs
+}
+
+func genModFileRunConfig(mRC module_lib.RunConfig) model.RunConfig {
+	mfRC := model.RunConfig{
+		StopSignal: mRC.StopSignal,
+		PseudoTTY:  mRC.PseudoTTY,
+		Command:    model.StrOrSlice(mRC.Command),
+	}
+	if mRC.StopTimeout != 5*time.Second {
+		d := model.Duration(mRC.StopTimeout)
+		mfRC.StopTimeout = &d
+	}
+	return mfRC
+}
+
+func genModFileBindMounts(mBMs map[string]module_lib.BindMount) []model.BindMount {
+	var mfBMs []model.BindMount
+	for _, mntPoint := range maputil.SortedKeys(mBMs) {
+		mBM := mBMs[mntPoint]
+		mfBMs = append(mfBMs, model.BindMount{
+			MountPoint: mntPoint,
+			Source:     mBM.Source,
+			ReadOnly:   mBM.ReadOnly,
+		})
+	}
+	return mfBMs
+}
+
+func genModFileTmpfsMounts(mTMs map[string]module_lib.TmpfsMount) []model.TmpfsMount {
+	var mfTMs []model.TmpfsMount
+	for _, mntPoint := range maputil.SortedKeys(mTMs) {
+		mTM := mTMs[mntPoint]
+		mfTM := model.TmpfsMount{
+			MountPoint: mntPoint,
+			Size:       model.ByteFmt(mTM.Size),
+		}
+		if mTM.Mode != fs.FileMode(504) {
+			m := model.FileMode(mTM.Mode)
+			mfTM.Mode = &m
+		}
+		mfTMs = append(mfTMs, mfTM)
+	}
+	return mfTMs
+}
+
+func genModFileHttpEndpoints(mHEs map[string]module_lib.HttpEndpoint) []model.HttpEndpoint {
+	var mfHEs []model.HttpEndpoint
+	for _, extPath := range maputil.SortedKeys(mHEs) {
+		mHE := mHEs[extPath]
+		mfHE := model.HttpEndpoint{
+			Name:    mHE.Name,
+			Path:    mHE.Path,
+			ExtPath: extPath,
+			ProxyConf: model.HttpEndpointProxyConf{
+				Headers:   mHE.ProxyConf.Headers,
+				WebSocket: mHE.ProxyConf.WebSocket,
+			},
+			StringSub: model.HttpEndpointStrSub{
+				ReplaceOnce: mHE.StringSub.ReplaceOnce,
+				MimeTypes:   mHE.StringSub.MimeTypes,
+				Filters:     mHE.StringSub.Filters,
+			},
+		}
+		if mHE.Port != 80 {
+			mfHE.Port = mHE.Port
+		}
+		if mHE.ProxyConf.ReadTimeout != 0 {
+			d := model.Duration(mHE.ProxyConf.ReadTimeout)
+			mfHE.ProxyConf.ReadTimeout = &d
+		}
+		mfHEs = append(mfHEs, mfHE)
+	}
+	return mfHEs
+}
+
+func genModFilePorts(mPs []module_lib.Port) ([]model.SrvPort, error) {
+	var mfSPs []model.SrvPort
+	for _, mP := range mPs {
+		mfSP := model.SrvPort{
+			Name: mP.Name,
+			Port: model.Port(strconv.FormatInt(int64(mP.Number), 10)),
+		}
+		if mP.Protocol != module_lib.TcpPort {
+			mfSP.Protocol = mP.Protocol
+		}
+		switch l := len(mP.Bindings); {
+		case l == 1:
+			mfSP.HostPort = model.Port(strconv.FormatInt(int64(mP.Bindings[0]), 10))
+		case l > 1:
+			for i := 1; i < l; i++ {
+				if mP.Bindings[i] != mP.Bindings[i-1]+1 {
+					return nil, fmt.Errorf("port %d: host ports %v not a range", mP.Number, mP.Bindings)
+				}
+			}
+			mfSP.HostPort = model.Port(fmt.Sprintf("%d-%d", mP.Bindings[0], mP.Bindings[l-1]))
+		}
+		mfSPs = append(mfSPs, mfSP)
+	}
+	return mfSPs, nil
+}
+
+func genModFileSrvReferences(mSs map[string]module_lib.Service, mAs map[string]module_lib.AuxService) map[string][]model.DependencyTarget {
+	type key struct {
+		ref      string
+		refVar   string
+		template string
+	}
+	var tg targets[key]
+	for _, sRef := range maputil.SortedKeys(mSs) {
+		for refVar, mSRT := range mSs[sRef].SrvReferences {
+			tg.add(key{ref: mSRT.Ref, refVar: refVar, template: mSRT.Template}, sRef, false)
+		}
+	}
+	for _, aRef := range maputil.SortedKeys(mAs) {
+		for refVar, mSRT := range mAs[aRef].SrvReferences {
+			tg.add(key{ref: mSRT.Ref, refVar: refVar, template: mSRT.Template}, aRef, true)
+		}
+	}
+	if len(tg.keys) == 0 {
+		return nil
+	}
+	mfSRs := make(map[string][]model.DependencyTarget)
+	for _, k := range tg.sortedKeys(func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.ref, b.ref), cmp.Compare(a.refVar, b.refVar), cmp.Compare(a.template, b.template))
+	}) {
+		mfSRs[k.ref] = append(mfSRs[k.ref], model.DependencyTarget{
+			RefVar:      k.refVar,
+			Template:    k.template,
+			Services:    tg.srv[k],
+			AuxServices: tg.aux[k],
+		})
+	}
+	return mfSRs
+}
+
+func genModFileVolumes(mVs map[string]struct{}, mSs map[string]module_lib.Service, mAs map[string]module_lib.AuxService) (map[string][]model.VolumeTarget, error) {
+	type key struct {
+		volume   string
+		mntPoint string
+	}
+	var tg targets[key]
+	for _, sRef := range maputil.SortedKeys(mSs) {
+		for mntPoint, vol := range mSs[sRef].Volumes {
+			if _, ok := mVs[vol]; !ok {
+				return nil, fmt.Errorf("service '%s' invalid volume: volume '%s' not defined", sRef, vol)
+			}
+			tg.add(key{volume: vol, mntPoint: mntPoint}, sRef, false)
+		}
+	}
+	for _, aRef := range maputil.SortedKeys(mAs) {
+		for mntPoint, vol := range mAs[aRef].Volumes {
+			if _, ok := mVs[vol]; !ok {
+				return nil, fmt.Errorf("aux service '%s' invalid volume: volume '%s' not defined", aRef, vol)
+			}
+			tg.add(key{volume: vol, mntPoint: mntPoint}, aRef, true)
+		}
+	}
+	if len(mVs) == 0 {
+		return nil, nil
+	}
+	mfVs := make(map[string][]model.VolumeTarget)
+	for vol := range mVs {
+		mfVs[vol] = nil
+	}
+	for _, k := range tg.sortedKeys(func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.volume, b.volume), cmp.Compare(a.mntPoint, b.mntPoint))
+	}) {
+		mfVs[k.volume] = append(mfVs[k.volume], model.VolumeTarget{
+			MountPoint:  k.mntPoint,
+			Services:    tg.srv[k],
+			AuxServices: tg.aux[k],
+		})
+	}
+	return mfVs, nil
+}
+
+func genModFileDependencies(mDs map[string]string, mSs map[string]module_lib.Service, mAs map[string]module_lib.AuxService) (map[string]model.ModuleDependency, error) {
+	type key struct {
+		id       string
+		service  string
+		refVar   string
+		template string
+	}
+	var tg targets[key]
+	for _, sRef := range maputil.SortedKeys(mSs) {
+		for refVar, mEDT := range mSs[sRef].ExtDependencies {
+			if _, ok := mDs[mEDT.ID]; !ok {
+				return nil, fmt.Errorf("service '%s' invalid module dependency: module '%s' not defined", sRef, mEDT.ID)
+			}
+			tg.add(key{id: mEDT.ID, service: mEDT.Service, refVar: refVar, template: mEDT.Template}, sRef, false)
+		}
+	}
+	for _, aRef := range maputil.SortedKeys(mAs) {
+		for refVar, mEDT := range mAs[aRef].ExtDependencies {
+			if _, ok := mDs[mEDT.ID]; !ok {
+				return nil, fmt.Errorf("aux service '%s' invalid module dependency: module '%s' not defined", aRef, mEDT.ID)
+			}
+			tg.add(key{id: mEDT.ID, service: mEDT.Service, refVar: refVar, template: mEDT.Template}, aRef, true)
+		}
+	}
+	if len(mDs) == 0 {
+		return nil, nil
+	}
+	mfMDs := make(map[string]model.ModuleDependency)
+	for id, ver := range mDs {
+		mfMDs[id] = model.ModuleDependency{Version: ver}
+	}
+	for _, k := range tg.sortedKeys(func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.id, b.id), cmp.Compare(a.service, b.service), cmp.Compare(a.refVar, b.refVar), cmp.Compare(a.template, b.template))
+	}) {
+		mfMD := mfMDs[k.id]
+		if mfMD.RequiredServices == nil {
+			mfMD.RequiredServices = make(map[string][]model.DependencyTarget)
+		}
+		mfMD.RequiredServices[k.service] = append(mfMD.RequiredServices[k.service], model.DependencyTarget{
+			RefVar:      k.refVar,
+			Template:    k.template,
+			Services:    tg.srv[k],
+			AuxServices: tg.aux[k],
+		})
+		mfMDs[k.id] = mfMD
+	}
+	return mfMDs, nil
+}
+
+func genModFileHostResources(mRs map[string]module_lib.HostResource, mIs map[string]module_lib.Input, mSs map[string]module_lib.Service) (map[string]model.HostResource, error) {
+	type key struct {
+		ref      string
+		mntPoint string
+		readOnly bool
+	}
+	var tg targets[key]
+	for _, sRef := range maputil.SortedKeys(mSs) {
+		for mntPoint, mRT := range mSs[sRef].HostResources {
+			if _, ok := mRs[mRT.Ref]; !ok {
+				return nil, fmt.Errorf("service '%s' invalid resource: resource '%s' not defined", sRef, mRT.Ref)
+			}
+			tg.add(key{ref: mRT.Ref, mntPoint: mntPoint, readOnly: mRT.ReadOnly}, sRef, false)
+		}
+	}
+	if len(mRs) == 0 {
+		return nil, nil
+	}
+	mfRs := make(map[string]model.HostResource)
+	for ref, mR := range mRs {
+		mfRs[ref] = model.HostResource{Resource: genModFileResource(mR.Resource, mIs, ref)}
+	}
+	for _, k := range tg.sortedKeys(func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.ref, b.ref), cmp.Compare(a.mntPoint, b.mntPoint))
+	}) {
+		mfR := mfRs[k.ref]
+		mfR.Targets = append(mfR.Targets, model.HostResourceTarget{
+			MountPoint: k.mntPoint,
+			Services:   tg.srv[k],
+			ReadOnly:   k.readOnly,
+		})
+		mfRs[k.ref] = mfR
+	}
+	return mfRs, nil
+}
+
+func genModFileSecrets(mSecs map[string]module_lib.Secret, mIs map[string]module_lib.Input, mSs map[string]module_lib.Service) (map[string]model.Secret, error) {
+	type key struct {
+		ref      string
+		mntPoint string
+		refVar   string
+		item     string
+	}
+	var tg targets[key]
+	for _, sRef := range maputil.SortedKeys(mSs) {
+		mS := mSs[sRef]
+		for mntPoint, mST := range mS.SecretMounts {
+			if _, ok := mSecs[mST.Ref]; !ok {
+				return nil, fmt.Errorf("service '%s' invalid secret: secret '%s' not defined", sRef, mST.Ref)
+			}
+			tg.add(key{ref: mST.Ref, mntPoint: mntPoint, item: mST.Item}, sRef, false)
+		}
+		for refVar, mST := range mS.SecretVars {
+			if _, ok := mSecs[mST.Ref]; !ok {
+				return nil, fmt.Errorf("service '%s' invalid secret: secret '%s' not defined", sRef, mST.Ref)
+			}
+			tg.add(key{ref: mST.Ref, refVar: refVar, item: mST.Item}, sRef, false)
+		}
+	}
+	if len(mSecs) == 0 {
+		return nil, nil
+	}
+	mfSecs := make(map[string]model.Secret)
+	for ref, mSec := range mSecs {
+		mfSecs[ref] = model.Secret{
+			Resource: genModFileResource(mSec.Resource, mIs, ref),
+			Type:     mSec.Type,
+		}
+	}
+	for _, k := range tg.sortedKeys(func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.ref, b.ref), cmp.Compare(a.mntPoint, b.mntPoint), cmp.Compare(a.refVar, b.refVar), cmp.Compare(a.item, b.item))
+	}) {
+		mfSec := mfSecs[k.ref]
+		mfSec.Targets = append(mfSec.Targets, model.SecretTarget{
+			MountPoint: k.mntPoint,
+			RefVar:     k.refVar,
+			Item:       k.item,
+			Services:   tg.srv[k],
+		})
+		mfSecs[k.ref] = mfSec
+	}
+	return mfSecs, nil
+}
+
+func genModFileResource(mR module_lib.Resource, mIs map[string]module_lib.Input, ref string) model.Resource {
+	mfR := model.Resource{
+		Tags:     maputil.SortedKeys(mR.Tags),
+		Optional: !mR.Required,
+	}
+	if mI, ok := mIs[ref]; ok {
+		mfUI := model.UserInput(mI)
+		mfR.UserInput = &mfUI
+	}
+	return mfR
+}
+
+func genModFileConfigs(mCs module_lib.Configs, mIs map[string]module_lib.Input, mSs map[string]module_lib.Service, mAs map[string]module_lib.AuxService) (map[string]model.ConfigValue, error) {
+	type key struct {
+		ref    string
+		refVar string
+	}
+	var tg targets[key]
+	for _, sRef := range maputil.SortedKeys(mSs) {
+		for refVar, cRef := range mSs[sRef].Configs {
+			if _, ok := mCs[cRef]; !ok {
+				return nil, fmt.Errorf("service '%s' invalid config: config '%s' not defined", sRef, cRef)
+			}
+			tg.add(key{ref: cRef, refVar: refVar}, sRef, false)
+		}
+	}
+	for _, aRef := range maputil.SortedKeys(mAs) {
+		for refVar, cRef := range mAs[aRef].Configs {
+			if _, ok := mCs[cRef]; !ok {
+				return nil, fmt.Errorf("aux service '%s' invalid config: config '%s' not defined", aRef, cRef)
+			}
+			tg.add(key{ref: cRef, refVar: refVar}, aRef, true)
+		}
+	}
+	if len(mCs) == 0 {
+		return nil, nil
+	}
+	mfCVs := make(map[string]model.ConfigValue)
+	for ref, mCV := range mCs {
+		mfCV, err := genModFileConfig(mCV, mIs, ref)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config '%s': %s", ref, err)
+		}
+		mfCVs[ref] = mfCV
+	}
+	for _, k := range tg.sortedKeys(func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.ref, b.ref), cmp.Compare(a.refVar, b.refVar))
+	}) {
+		mfCV := mfCVs[k.ref]
+		mfCV.Targets = append(mfCV.Targets, model.ConfigTarget{
+			RefVar:      k.refVar,
+			Services:    tg.srv[k],
+			AuxServices: tg.aux[k],
+		})
+		mfCVs[k.ref] = mfCV
+	}
+	return mfCVs, nil
+}
+
+func genModFileConfig(mCV module_lib.ConfigValue, mIs map[string]module_lib.Input, ref string) (model.ConfigValue, error) {
+	dataType := mCV.DataType
+	mfCV := model.ConfigValue{
+		Value:      mCV.Default,
+		OptionsExt: mCV.OptExt,
+		DataType:   &dataType,
+		IsList:     mCV.IsSlice,
+		Optional:   !mCV.Required,
+	}
+	var err error
+	if mCV.IsSlice {
+		if mfCV.Value, err = genAnySlice(mCV.Default); err != nil {
+			return model.ConfigValue{}, err
+		}
+		delimiter := mCV.Delimiter
+		mfCV.Delimiter = &delimiter
+	}
+	if mfCV.Options, err = genAnySlice(mCV.Options); err != nil {
+		return model.ConfigValue{}, err
+	}
+	mI, ok := mIs[ref]
+	if ok || mCV.Type != "" || len(mCV.TypeOpt) > 0 {
+		mfCV.UserInput = &model.ConfigUserInput{
+			UserInput: model.UserInput(mI),
+			Type:      mCV.Type,
+		}
+		if len(mCV.TypeOpt) > 0 {
+			mfCV.UserInput.TypeOptions = make(map[string]any)
+			for key, opt := range mCV.TypeOpt {
+				mfCV.UserInput.TypeOptions[key] = opt.Value
+			}
+		}
+	}
+	return mfCV, nil
+}
+
+func genModFileFiles(mFs map[string]module_lib.File, mIs map[string]module_lib.Input, mSs map[string]module_lib.Service) (map[string]model.File, error) {
+	type key struct {
+		ref      string
+		mntPoint string
+	}
+	var tg targets[key]
+	for _, sRef := range maputil.SortedKeys(mSs) {
+		for mntPoint, fRef := range mSs[sRef].Files {
+			if _, ok := mFs[fRef]; !ok {
+				return nil, fmt.Errorf("service '%s' invalid file: file '%s' not defined", sRef, fRef)
+			}
+			tg.add(key{ref: fRef, mntPoint: mntPoint}, sRef, false)
+		}
+	}
+	if len(mFs) == 0 {
+		return nil, nil
+	}
+	mfFs := make(map[string]model.File)
+	for ref, mF := range mFs {
+		mfFs[ref] = model.File{
+			Source: mF.Source,
+			UserInput: model.FileUserInput{
+				UserInput: model.UserInput(mIs[ref]),
+				Type:      mF.Type,
+			},
+			Optional: !mF.Required,
+		}
+	}
+	for _, k := range tg.sortedKeys(func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.ref, b.ref), cmp.Compare(a.mntPoint, b.mntPoint))
+	}) {
+		mfF := mfFs[k.ref]
+		mfF.Targets = append(mfF.Targets, model.FileTarget{
+			MountPoint: k.mntPoint,
+			Services:   tg.srv[k],
+		})
+		mfFs[k.ref] = mfF
+	}
+	return mfFs, nil
+}
+
+func genModFileFileGroups(mFGs map[string]struct{}, mIs map[string]module_lib.Input, mSs map[string]module_lib.Service) (map[string]model.FileGroup, error) {
+	type key struct {
+		ref      string
+		basePath string
+	}
+	var tg targets[key]
+	for _, sRef := range maputil.SortedKeys(mSs) {
+		for basePath, gRef := range mSs[sRef].FileGroups {
+			if _, ok := mFGs[gRef]; !ok {
+				return nil, fmt.Errorf("service '%s' invalid file group: file group '%s' not defined", sRef, gRef)
+			}
+			tg.add(key{ref: gRef, basePath: basePath}, sRef, false)
+		}
+	}
+	if len(mFGs) == 0 {
+		return nil, nil
+	}
+	mfFGs := make(map[string]model.FileGroup)
+	for ref := range mFGs {
+		mfFGs[ref] = model.FileGroup{UserInput: model.UserInput(mIs[ref])}
+	}
+	for _, k := range tg.sortedKeys(func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.ref, b.ref), cmp.Compare(a.basePath, b.basePath))
+	}) {
+		mfFG := mfFGs[k.ref]
+		mfFG.Targets = append(mfFG.Targets, model.FileGroupTarget{
+			BasePath: k.basePath,
+			Services: tg.srv[k],
+		})
+		mfFGs[k.ref] = mfFG
+	}
+	return mfFGs, nil
+}
+
+func genModFileInputGroups(mIGs map[string]module_lib.InputGroup) map[string]model.InputGroup {
+	if len(mIGs) == 0 {
+		return nil
+	}
+	mfIGs := make(map[string]model.InputGroup)
+	for ref, mIG := range mIGs {
+		mfIGs[ref] = model.InputGroup(mIG)
+	}
+	return mfIGs
+}
+
+// targets collects the services and aux services sharing the same target.
+type targets[K comparable] struct {
+	keys []K
+	srv  map[K][]string
+	aux  map[K][]string
+}
+
+func (t *targets[K]) add(k K, ref string, aux bool) {
+	if t.srv == nil {
+		t.srv = make(map[K][]string)
+		t.aux = make(map[K][]string)
+	}
+	if t.srv[k] == nil && t.aux[k] == nil {
+		t.keys = append(t.keys, k)
+	}
+	if aux {
+		t.aux[k] = append(t.aux[k], ref)
+	} else {
+		t.srv[k] = append(t.srv[k], ref)
+	}
+}
+
+func (t *targets[K]) sortedKeys(cmpFunc func(a, b K) int) []K {
+	keys := slices.Clone(t.keys)
+	slices.SortFunc(keys, cmpFunc)
+	return keys
+}
+
+// genModFileArchitectures maps canonical platform identifiers back to the architecture names allowed by the modfile.
+func genModFileArchitectures(architectures map[module_lib.CPUArch]struct{}) []string {
+	archs := maputil.SortedKeys(architectures)
+	for i, arch := range archs {
+		if p, err := platform.Parse(arch); err == nil && p.Alias() != "" {
+			archs[i] = p.Alias()
+		}
+	}
+	return archs
+}
+
+func genAnySlice(val any) ([]any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("type missmatch: %T != slice", val)
+	}
+	if rv.Len() == 0 {
+		return nil, nil
+	}
+	sl := make([]any, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		sl[i] = rv.Index(i).Interface()
+	}
+	return sl, nil
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func TestGetModFile(t *testing.T) {
+	strType := module_lib.StringType
+	floatType := module_lib.Float64Type
+	timeout := model.Duration(time.Second)
+	mode := model.FileMode(0777)
+	mf := model.ModFile{
+		ID:      "id",
+		Version: "ver",
+		Services: map[string]model.Service{
+			"a": {
+				Image:     "img:v1",
+				RunConfig: model.RunConfig{StopTimeout: &timeout, Command: model.StrOrSlice{"cmd"}},
+				Tmpfs:     []model.TmpfsMount{{MountPoint: "tmp", Size: 64, Mode: &mode}},
+				Ports: []model.SrvPort{
+					{Port: "80-81", HostPort: "8080-8081"},
+					{Port: "53", HostPort: "5353-5354", Protocol: "udp"},
+				},
+			},
+			"b": {Image: "img:v2"},
+		},
+		ServiceReferences: map[string][]model.DependencyTarget{
+			"b": {{RefVar: "ref", Template: "http://{ref}", Services: []string{"a"}}},
+		},
+		Volumes: map[string][]model.VolumeTarget{
+			"vol":  {{MountPoint: "mnt", Services: []string{"a", "b"}}},
+			"vol2": nil,
+		},
+		Configs: map[string]model.ConfigValue{
+			"cfg": {
+				Value:    1.5,
+				Options:  []any{1.5, 2.0},
+				DataType: &floatType,
+				Targets:  []model.ConfigTarget{{RefVar: "cfg", Services: []string{"a"}}},
+			},
+			"lst": {
+				Value:    []any{"a"},
+				DataType: &strType,
+				IsList:   true,
+				Optional: true,
+			},
+		},
+	}
+	a, err := generateModule(mf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mf2, err := GetModFile(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mf2.ModfileVersion != model.Version {
+		t.Errorf("%s != %s", mf2.ModfileVersion, model.Version)
+	}
+	if b, err := generateModule(mf2); err != nil {
+		t.Error(err)
+	} else if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+	// --------------------------------
+	if _, err = GetModFile(module_lib.Module{
+		Services: map[string]module_lib.Service{
+			"a": {Volumes: map[string]string{"mnt": "vol"}},
+		},
+	}); err == nil {
+		t.Error("err == nil")
+	}
+	// --------------------------------
+	if _, err = GetModFile(module_lib.Module{
+		Services: map[string]module_lib.Service{
+			"a": {Ports: []module_lib.Port{{Number: 80, Bindings: []int{8080, 8082}}}},
+		},
+	}); err == nil {
+		t.Error("err == nil")
+	}
+	// --------------------------------
+	if _, err = GetModFile(module_lib.Module{
+		Configs: module_lib.Configs{"cfg": {IsSlice: true, Default: "a"}},
+	}); err == nil {
+		t.Error("err == nil")
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mounts
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/validation"
+)
+
+// CheckFiles reads the default content and schema of each file from fsys and checks that the content can be
+// decoded according to the file type and satisfies the schema. Files larger than maxSize bytes are rejected, a
+// maxSize of zero disables the limit. The default content of generic files is not parsed and therefore not read.
+func CheckFiles(fsys fs.FS, mfFiles map[string]model.File, maxSize int64) error {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mfFiles) {
+		file := mfFiles[ref]
+		var schema *validation.Schema
+		if file.Schema != "" {
+			b, err := readFile(fsys, file.Schema, maxSize)
+			if err == nil {
+				schema, err = validation.ParseSchema(b)
+			}
+			if err != nil {
+				errs = append(errs, model.NewError(model.Path{"files", ref, "schema"}, fmt.Errorf("file '%s' schema: %w", ref, err)))
+				continue
+			}
+			if file.UserInput.Type != validation.TypeJson && file.UserInput.Type != validation.TypeYaml {
+				errs = append(errs, model.NewError(model.Path{"files", ref, "schema"}, fmt.Errorf("file '%s' schema: file type must be '%s' or '%s'", ref, validation.TypeJson, validation.TypeYaml)))
+				continue
+			}
+		}
+		if file.Source == "" {
+			continue
+		}
+		var err error
+		if file.UserInput.Type != validation.TypeJson && file.UserInput.Type != validation.TypeYaml {
+			err = statFile(fsys, file.Source)
+		} else {
+			var b []byte
+			if b, err = readFile(fsys, file.Source, maxSize); err == nil {
+				err = validation.ValidateContent(file.UserInput.Type, b, schema)
+			}
+		}
+		if err != nil {
+			errs = append(errs, model.NewError(model.Path{"files", ref, "source"}, fmt.Errorf("file '%s' invalid default content: %w", ref, err)))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+func readFile(fsys fs.FS, name string, maxSize int64) ([]byte, error) {
+	p, err := model.RepoPath("", name)
+	if err != nil {
+		return nil, fmt.Errorf("'%s': %w", name, err)
+	}
+	return model.ReadFile(fsys, p, maxSize)
+}
+
+func statFile(fsys fs.FS, name string) error {
+	p, err := model.RepoPath("", name)
+	if err != nil {
+		return fmt.Errorf("'%s': %w", name, err)
+	}
+	_, err = fs.Stat(fsys, p)
+	return err
+}
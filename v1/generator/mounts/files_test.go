@@ -0,0 +1,82 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mounts
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/validation"
+)
+
+func TestCheckFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"valid.json":          {Data: []byte(`{"a": 1}`)},
+		"invalid.json":        {Data: []byte(`{"a": 1`)},
+		"valid.yml":           {Data: []byte("a: 1\n")},
+		"wrong.yml":           {Data: []byte("a: test\n")},
+		"schema.json":         {Data: []byte(`{"type": "object", "properties": {"a": {"type": "integer"}}}`)},
+		"invalid-schema.json": {Data: []byte(`{"type": "object", "properties": {"a": {"pattern": "["}}}`)},
+	}
+	mfFiles := map[string]model.File{
+		"a": {Source: "valid.json", UserInput: model.FileUserInput{Type: validation.TypeJson}},
+		"b": {Source: "./valid.yml", Schema: "schema.json", UserInput: model.FileUserInput{Type: validation.TypeYaml}},
+		"c": {Source: "invalid.json", UserInput: model.FileUserInput{Type: validation.TypeGeneric}},
+		"d": {UserInput: model.FileUserInput{Type: validation.TypeJson}},
+		"e": {Schema: "schema.json", UserInput: model.FileUserInput{Type: validation.TypeJson}},
+	}
+	if err := CheckFiles(fsys, mfFiles, 0); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	tests := []struct {
+		file  model.File
+		field string
+	}{
+		{model.File{Source: "invalid.json", UserInput: model.FileUserInput{Type: validation.TypeJson}}, "source"},
+		{model.File{Source: "wrong.yml", Schema: "schema.json", UserInput: model.FileUserInput{Type: validation.TypeYaml}}, "source"},
+		{model.File{Source: "missing.json", UserInput: model.FileUserInput{Type: validation.TypeJson}}, "source"},
+		{model.File{Source: "/valid.json", UserInput: model.FileUserInput{Type: validation.TypeJson}}, "source"},
+		{model.File{Source: "../valid.json", UserInput: model.FileUserInput{Type: validation.TypeJson}}, "source"},
+		{model.File{Source: "missing.json", UserInput: model.FileUserInput{Type: validation.TypeGeneric}}, "source"},
+		{model.File{Source: "sub\\valid.json", UserInput: model.FileUserInput{Type: validation.TypeGeneric}}, "source"},
+		{model.File{Schema: "missing.json", UserInput: model.FileUserInput{Type: validation.TypeJson}}, "schema"},
+		{model.File{Schema: "invalid-schema.json", UserInput: model.FileUserInput{Type: validation.TypeJson}}, "schema"},
+		{model.File{Schema: "schema.json", UserInput: model.FileUserInput{Type: validation.TypeGeneric}}, "schema"},
+	}
+	for i, tc := range tests {
+		err := CheckFiles(fsys, map[string]model.File{"test": tc.file}, 0)
+		var e *model.Error
+		if !errors.As(err, &e) {
+			t.Errorf("%d: not *Error", i)
+			continue
+		}
+		if p := (model.Path{"files", "test", tc.field}).String(); e.Path.String() != p {
+			t.Errorf("%d: %s != %s", i, e.Path, p)
+		}
+	}
+	// ---------------------------
+	var lErr *model.LimitError
+	if err := CheckFiles(fsys, map[string]model.File{"test": mfFiles["b"]}, 16); !errors.As(err, &lErr) {
+		t.Error("not *LimitError")
+	}
+	if err := CheckFiles(fsys, map[string]model.File{"test": mfFiles["c"]}, 1); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/imageref"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/mounts"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/services"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Modfile is a decoded modfile of a specific version, see Pipeline.
+type Modfile interface {
+	// Generate returns the module even if errors occur, all errors found in the modfile are returned as a single
+	// joined error. Module IDs are used as declared, they are set to their canonical form by the pipeline.
+	Generate() (module_lib.Module, error)
+	// Files returns the file definitions as v1 files, used to check default file content.
+	Files() map[string]model.File
+}
+
+// Pipeline decodes and generates modules of a specific modfile version. Only decoding and generation are version
+// specific, the preparation of the document (see Prepare) and the checks of the generated module are shared.
+type Pipeline struct {
+	// Model is the zero value of the modfile model, used to report unknown fields, see model.CheckModelFields.
+	Model any
+	// Decode decodes the modfile document.
+	Decode func(yn *yaml.Node) (Modfile, error)
+}
+
+// Run prepares the modfile document and generates the module. Returned errors are located in yn.
+func (p Pipeline) Run(yn *yaml.Node, opt Options) (module_lib.Module, error) {
+	inc, err := Prepare(yn, opt)
+	if err != nil {
+		return module_lib.Module{}, err
+	}
+	return p.Generate(yn, inc, opt)
+}
+
+// Generate decodes a modfile document prepared by Prepare and generates the module. Returned errors are located
+// in yn using inc.
+func (p Pipeline) Generate(yn *yaml.Node, inc model.Includes, opt Options) (module_lib.Module, error) {
+	var errs []error
+	if opt.Strict || opt.Warn != nil {
+		if err := model.CheckModelFields(yn, p.Model); err != nil {
+			if opt.Strict {
+				errs = append(errs, err)
+			} else {
+				warn(opt.Warn, inc.Locate(err, yn))
+			}
+		}
+	}
+	mf, err := p.Decode(yn)
+	if err != nil {
+		return module_lib.Module{}, inc.Locate(errors.Join(append(errs, err)...), yn)
+	}
+	if opt.FileSchemas != nil {
+		for ref, file := range mf.Files() {
+			if file.Schema != "" {
+				opt.FileSchemas[ref] = file.Schema
+			}
+		}
+	}
+	mod, err := mf.Generate()
+	if err != nil {
+		errs = append(errs, err)
+	}
+	mutableTags := opt.MutableTags
+	if mutableTags == nil {
+		mutableTags = imageref.DefaultMutableTags
+	}
+	if err = services.CheckImageTags(images(mod.Services), mutableTags); err != nil {
+		errs = append(errs, err)
+	}
+	if err = CheckVersions(mod.Version, mod.Dependencies); err != nil {
+		errs = append(errs, err)
+	}
+	if err = CanonicalModule(&mod); err != nil {
+		errs = append(errs, err)
+	}
+	if opt.FS != nil {
+		if err = mounts.CheckFiles(opt.FS, mf.Files(), opt.MaxSize); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return module_lib.Module{}, inc.Locate(errors.Join(errs...), yn)
+	}
+	return mod, nil
+}
+
+// Prepare resolves includes, checks limits and expands variables as configured by opt. These steps work on the
+// document and apply to all modfile versions. The returned includes are required to locate errors, see Pipeline.Generate.
+func Prepare(yn *yaml.Node, opt Options) (model.Includes, error) {
+	inc, err := model.ResolveIncludesWithLimits(yn, opt.FS, "", model.IncludeLimits{
+		MaxFileSize: opt.MaxSize,
+		MaxSize:     opt.MaxIncludeSize,
+		MaxIncludes: opt.MaxIncludes,
+		MaxNodes:    opt.MaxNodes,
+		MaxDepth:    opt.MaxDepth,
+	})
+	if err != nil {
+		return inc, inc.Locate(err, yn)
+	}
+	if opt.IncludedFiles != nil {
+		for _, name := range inc {
+			opt.IncludedFiles[name] = struct{}{}
+		}
+	}
+	if err = model.CheckLimits(yn, opt.MaxNodes, opt.MaxDepth); err != nil {
+		return inc, inc.Locate(err, yn)
+	}
+	if opt.Vars != nil {
+		if err = model.Interpolate(yn, opt.Vars); err != nil {
+			return inc, inc.Locate(err, yn)
+		}
+	}
+	if err = model.CheckPorts(yn, opt.MaxPorts); err != nil {
+		return inc, inc.Locate(err, yn)
+	}
+	return inc, nil
+}
+
+func images(mSs map[string]module_lib.Service) map[string]string {
+	m := make(map[string]string)
+	for ref, mS := range mSs {
+		m[ref] = mS.Image
+	}
+	return m
+}
+
+func warn(f func(error), err error) {
+	if je, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range je.Unwrap() {
+			f(e)
+		}
+		return
+	}
+	f(err)
+}
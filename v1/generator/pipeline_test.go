@@ -0,0 +1,95 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/imageref"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+type testModfile struct {
+	mod module_lib.Module
+}
+
+func (m testModfile) Generate() (module_lib.Module, error) {
+	return m.mod, nil
+}
+
+func (m testModfile) Files() map[string]model.File {
+	return nil
+}
+
+func TestPipeline(t *testing.T) {
+	p := Pipeline{
+		Model: struct {
+			ID string `yaml:"id"`
+		}{},
+		Decode: func(yn *yaml.Node) (Modfile, error) {
+			var mod module_lib.Module
+			if err := yn.Decode(&mod); err != nil {
+				return nil, err
+			}
+			mod.Version = "v1.0.0"
+			mod.Services = map[string]module_lib.Service{"a": {Image: "a:latest"}}
+			return testModfile{mod: mod}, nil
+		},
+	}
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte("id: https://github.com/user/repo\n"), &yn); err != nil {
+		t.Fatal(err)
+	}
+	mod, err := p.Run(&yn, Options{Strict: true, MutableTags: []string{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mod.ID != "github.com/user/repo" {
+		t.Error(mod.ID)
+	}
+	if _, err = p.Run(&yn, Options{Strict: true}); !errors.Is(err, imageref.ErrMutableTag) {
+		t.Error("expected ErrMutableTag")
+	}
+	// ---------------------------
+	if err = yaml.Unmarshal([]byte("id: user/repo\nname: test\n"), &yn); err != nil {
+		t.Fatal(err)
+	}
+	_, err = p.Run(&yn, Options{Strict: true, MaxNodes: 100})
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors")
+	}
+	var paths []string
+	for _, e := range je.Unwrap() {
+		var mErr *model.Error
+		if !errors.As(e, &mErr) {
+			t.Fatal("not *Error")
+		}
+		paths = append(paths, mErr.Path.String())
+	}
+	if len(paths) != 3 || paths[0] != "id" || paths[1] != "name" || paths[2] != "services.a.image" {
+		t.Errorf("%v", paths)
+	}
+	// ---------------------------
+	var lErr *model.LimitError
+	if _, err = p.Run(&yn, Options{MaxNodes: 2}); !errors.As(err, &lErr) {
+		t.Error("not *LimitError")
+	}
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package services
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Modfile sections referenced by UndefinedRefError and DuplicateTargetError.
+const (
+	SectionServiceReferences = "serviceReferences"
+	SectionVolumes           = "volumes"
+	SectionDependencies      = "dependencies"
+	SectionHostResources     = "hostResources"
+	SectionFiles             = "files"
+	SectionFileGroups        = "fileGroups"
+	SectionSecrets           = "secrets"
+	SectionConfigs           = "configs"
+	SectionInclude           = "include"
+	SectionTmpfs             = "tmpfs"
+	SectionHttpEndpoints     = "httpEndpoints"
+)
+
+var sectionNames = map[string]string{
+	SectionServiceReferences: "service reference",
+	SectionVolumes:           "volume",
+	SectionDependencies:      "module dependency",
+	SectionHostResources:     "resource",
+	SectionFiles:             "file",
+	SectionFileGroups:        "file group",
+	SectionSecrets:           "secret",
+	SectionConfigs:           "config",
+	SectionInclude:           "bind mount",
+	SectionTmpfs:             "tmpfs mount",
+	SectionHttpEndpoints:     "http endpoint",
+}
+
+// UndefinedRefError indicates that an element of a section targets a service or aux service that is not defined.
+type UndefinedRefError struct {
+	Section string // section of the referencing element, e.g. "volumes"
+	Key     string // key of the referencing element, e.g. the volume name
+	Ref     string // referenced service
+	Aux     bool   // true if Ref is an aux service reference
+	Target  string // mount point, reference variable or base path the element was to be set at
+}
+
+func (e *UndefinedRefError) Error() string {
+	return fmt.Sprintf("invalid %s: %s '%s' not defined", sectionNames[e.Section], srvKind(e.Aux), e.Ref)
+}
+
+// DuplicateTargetError indicates that two different elements claim the same target (mount point, reference
+// variable, base path or external path) of a service. Key is empty for targets declared within a service definition
+// (include, tmpfs, httpEndpoints), in this case the error is wrapped with the service context.
+type DuplicateTargetError struct {
+	Section  string // section of the claiming element
+	Key      string // key of the claiming element
+	Existing string // key or source of the element already holding the target, if known
+	// ExistingService is the service of the module dependency already holding the target, only set for SectionDependencies.
+	ExistingService string
+	Service         string // affected service
+	Aux             bool   // true if Service is an aux service
+	Target          string // mount point, reference variable, base path or external path
+}
+
+func (e *DuplicateTargetError) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("duplicate '%s'", e.Target)
+	}
+	existing := e.Existing
+	if e.ExistingService != "" {
+		existing += " " + e.ExistingService
+	}
+	return fmt.Sprintf("%s '%s' invalid %s: duplicate '%s' ('%s' & '%s')", srvKind(e.Aux), e.Service, sectionNames[e.Section], e.Target, existing, e.Key)
+}
+
+// InvalidRangeError indicates that the port range of a port mapping does not match the host port range.
+type InvalidRangeError struct {
+	Service   string
+	Port      string
+	HostPort  string
+	Ports     int // number of ports
+	HostPorts int // number of host ports
+}
+
+func (e *InvalidRangeError) Error() string {
+	if e.Ports > e.HostPorts {
+		return "range mismatch: ports > host ports"
+	}
+	return "range mismatch: ports < host ports"
+}
+
+// MountCollisionError indicates that two mounts of a service share a mount point or that a mount is nested below
+// a file mount. Key and ExistingKey identify the sources (e.g. volume name or bind mount source), tmpfs mounts have none.
+type MountCollisionError struct {
+	Service         string
+	Aux             bool
+	Target          string // mount point
+	Section         string // section of the mount
+	Key             string
+	ExistingTarget  string // mount point of the competing mount, differs from Target if Target is nested
+	ExistingSection string // section of the competing mount
+	ExistingKey     string
+}
+
+func (e *MountCollisionError) Error() string {
+	return fmt.Sprintf("%s '%s' mount point collision: %s at '%s' conflicts with %s at '%s'", srvKind(e.Aux), e.Service, mountSource(e.Section, e.Key), e.Target, mountSource(e.ExistingSection, e.ExistingKey), e.ExistingTarget)
+}
+
+func mountSource(section, key string) string {
+	if key == "" {
+		return sectionNames[section]
+	}
+	return fmt.Sprintf("%s '%s'", sectionNames[section], key)
+}
+
+func srvKind(aux bool) string {
+	if aux {
+		return "aux service"
+	}
+	return "service"
+}
+
+// setService sets the service of all typed errors contained in err.
+func setService(err error, ref string, aux bool) {
+	if je, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range je.Unwrap() {
+			setService(e, ref, aux)
+		}
+		return
+	}
+	var dtErr *DuplicateTargetError
+	if errors.As(err, &dtErr) {
+		dtErr.Service = ref
+		dtErr.Aux = aux
+	}
+	var irErr *InvalidRangeError
+	if errors.As(err, &irErr) {
+		irErr.Service = ref
+	}
+}
@@ -18,35 +18,46 @@ package services
 
 import (
 	"errors"
-	"fmt"
 	"io/fs"
 	"time"
 
+	"github.com/SENERGY-Platform/mgw-modfile-lib/imageref"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
 	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
 	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
 )
 
+// GenServices returns all services even if errors occur, allowing subsequent setters to check references.
 func GenServices(mfSs map[string]model.Service) (map[string]module_lib.Service, error) {
 	if len(mfSs) == 0 {
 		return nil, nil
 	}
 	mSs := make(map[string]module_lib.Service)
-	for ref, mfS := range mfSs {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mfSs) {
+		mfS := mfSs[ref]
 		mBMs, err := GenBindMounts(mfS.Include)
 		if err != nil {
-			return nil, fmt.Errorf("service '%s' invalid bind mount: %s", ref, err)
+			setService(err, ref, false)
+			errs = append(errs, model.WrapErrors(err, model.Path{"services", ref, SectionInclude}, "service '%s' invalid bind mount: %w", ref))
 		}
 		mTMs, err := GenTmpfsMounts(mfS.Tmpfs)
 		if err != nil {
-			return nil, fmt.Errorf("service '%s' invalid tmpfsMount: %s", ref, err)
+			setService(err, ref, false)
+			errs = append(errs, model.WrapErrors(err, model.Path{"services", ref, SectionTmpfs}, "service '%s' invalid tmpfs mount: %w", ref))
 		}
 		mHEs, err := GenHttpEndpoints(mfS.HttpEndpoints)
 		if err != nil {
-			return nil, fmt.Errorf("service '%s' invalid http endpoint: %s", ref, err)
+			setService(err, ref, false)
+			errs = append(errs, model.WrapErrors(err, model.Path{"services", ref, SectionHttpEndpoints}, "service '%s' invalid http endpoint: %w", ref))
 		}
 		mPs, err := GenPorts(mfS.Ports)
 		if err != nil {
-			return nil, fmt.Errorf("service '%s' invalid port mapping: %s", ref, err)
+			setService(err, ref, false)
+			errs = append(errs, model.WrapErrors(err, model.Path{"services", ref, "ports"}, "service '%s' invalid port mapping: %w", ref))
+		}
+		if _, err = imageref.Check(mfS.Image, nil); err != nil {
+			errs = append(errs, model.WrapErrors(err, model.Path{"services", ref, "image"}, "service '%s' invalid image: %w", ref))
 		}
 		mSs[ref] = module_lib.Service{
 			Name:              mfS.Name,
@@ -59,22 +70,43 @@ func GenServices(mfSs map[string]model.Service) (map[string]module_lib.Service,
 			DeviceCGroupRules: mfS.DeviceCGroupRules,
 		}
 	}
-	return mSs, nil
+	return mSs, errors.Join(errs...)
 }
 
+// CheckImageTags reports services whose image, provided by images, uses one of mutableTags and is not pinned by digest.
+// Invalid images are skipped as they are reported by GenServices.
+func CheckImageTags(images map[string]string, mutableTags []string) error {
+	if len(mutableTags) == 0 {
+		return nil
+	}
+	var errs []error
+	for _, ref := range maputil.SortedKeys(images) {
+		image := images[ref]
+		if _, err := imageref.Check(image, mutableTags); errors.Is(err, imageref.ErrMutableTag) {
+			errs = append(errs, model.WrapErrors(err, model.Path{"services", ref, "image"}, "service '%s' invalid image: %w", ref))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GenAuxServices returns all aux services even if errors occur, allowing subsequent setters to check references.
 func GenAuxServices(mfSs map[string]model.AuxService) (map[string]module_lib.AuxService, error) {
 	if len(mfSs) == 0 {
 		return nil, nil
 	}
 	mAs := make(map[string]module_lib.AuxService)
-	for ref, mfS := range mfSs {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mfSs) {
+		mfS := mfSs[ref]
 		mBMs, err := GenBindMounts(mfS.Include)
 		if err != nil {
-			return nil, fmt.Errorf("aux service '%s' invalid bind mount: %s", ref, err)
+			setService(err, ref, true)
+			errs = append(errs, model.WrapErrors(err, model.Path{"auxServices", ref, SectionInclude}, "aux service '%s' invalid bind mount: %w", ref))
 		}
 		mTMs, err := GenTmpfsMounts(mfS.Tmpfs)
 		if err != nil {
-			return nil, fmt.Errorf("aux service '%s' invalid tmpfsMount: %s", ref, err)
+			setService(err, ref, true)
+			errs = append(errs, model.WrapErrors(err, model.Path{"auxServices", ref, SectionTmpfs}, "aux service '%s' invalid tmpfs mount: %w", ref))
 		}
 		mAs[ref] = module_lib.AuxService{
 			Name:       mfS.Name,
@@ -83,7 +115,7 @@ func GenAuxServices(mfSs map[string]model.AuxService) (map[string]module_lib.Aux
 			Tmpfs:      mTMs,
 		}
 	}
-	return mAs, nil
+	return mAs, errors.Join(errs...)
 }
 
 func GenRunConfig(mfRC model.RunConfig) module_lib.RunConfig {
@@ -106,18 +138,23 @@ func GenBindMounts(mfBMs []model.BindMount) (map[string]module_lib.BindMount, er
 		return nil, nil
 	}
 	mBMs := make(map[string]module_lib.BindMount)
-	for _, mfBM := range mfBMs {
+	var errs []error
+	for i, mfBM := range mfBMs {
 		if v, ok := mBMs[mfBM.MountPoint]; ok {
 			if v.Source == mfBM.Source && v.ReadOnly == mfBM.ReadOnly {
 				continue
 			}
-			return nil, fmt.Errorf("duplicate '%s'", mfBM.MountPoint)
+			errs = append(errs, model.NewError(model.Path{i, "mountPoint"}, &DuplicateTargetError{Section: SectionInclude, Existing: v.Source, Target: mfBM.MountPoint}))
+			continue
 		}
 		mBMs[mfBM.MountPoint] = module_lib.BindMount{
 			Source:   mfBM.Source,
 			ReadOnly: mfBM.ReadOnly,
 		}
 	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
 	return mBMs, nil
 }
 
@@ -126,12 +163,14 @@ func GenTmpfsMounts(mfTMs []model.TmpfsMount) (map[string]module_lib.TmpfsMount,
 		return nil, nil
 	}
 	mTMs := make(map[string]module_lib.TmpfsMount)
-	for _, mfTM := range mfTMs {
+	var errs []error
+	for i, mfTM := range mfTMs {
 		if v, ok := mTMs[mfTM.MountPoint]; ok {
 			if v.Size == int64(mfTM.Size) && (mfTM.Mode == nil || v.Mode == fs.FileMode(*mfTM.Mode)) {
 				continue
 			}
-			return nil, fmt.Errorf("duplicate '%s'", mfTM.MountPoint)
+			errs = append(errs, model.NewError(model.Path{i, "mountPoint"}, &DuplicateTargetError{Section: SectionTmpfs, Target: mfTM.MountPoint}))
+			continue
 		}
 		mTM := module_lib.TmpfsMount{
 			Size: int64(mfTM.Size),
@@ -142,6 +181,9 @@ func GenTmpfsMounts(mfTMs []model.TmpfsMount) (map[string]module_lib.TmpfsMount,
 		}
 		mTMs[mfTM.MountPoint] = mTM
 	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
 	return mTMs, nil
 }
 
@@ -150,9 +192,11 @@ func GenHttpEndpoints(mfHEs []model.HttpEndpoint) (map[string]module_lib.HttpEnd
 		return nil, nil
 	}
 	mHEs := make(map[string]module_lib.HttpEndpoint)
-	for _, mfHE := range mfHEs {
-		if _, ok := mHEs[mfHE.ExtPath]; ok {
-			return nil, fmt.Errorf("duplicate '%s'", mfHE.ExtPath)
+	var errs []error
+	for i, mfHE := range mfHEs {
+		if v, ok := mHEs[mfHE.ExtPath]; ok {
+			errs = append(errs, model.NewError(model.Path{i, "extPath"}, &DuplicateTargetError{Section: SectionHttpEndpoints, Existing: v.Name, Target: mfHE.ExtPath}))
+			continue
 		}
 		mHE := module_lib.HttpEndpoint{
 			Name: mfHE.Name,
@@ -176,35 +220,44 @@ func GenHttpEndpoints(mfHEs []model.HttpEndpoint) (map[string]module_lib.HttpEnd
 		}
 		mHEs[mfHE.ExtPath] = mHE
 	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
 	return mHEs, nil
 }
 
 func GenPorts(mfSPs []model.SrvPort) ([]module_lib.Port, error) {
 	var mPs []module_lib.Port
-	for _, mfSP := range mfSPs {
+	var errs []error
+	for i, mfSP := range mfSPs {
 		proto := module_lib.TcpPort
 		if mfSP.Protocol != "" {
 			proto = mfSP.Protocol
 		}
 		ep, err := mfSP.Port.Parse()
 		if err != nil {
-			return nil, err
+			errs = append(errs, model.NewError(model.Path{i, "port"}, err))
+			continue
 		}
 		var hp []int
 		if mfSP.HostPort != "" {
 			hp, err = mfSP.HostPort.Parse()
 			if err != nil {
-				return nil, err
+				errs = append(errs, model.NewError(model.Path{i, "hostPort"}, err))
+				continue
 			}
 		}
 		lep := len(ep)
 		lhp := len(hp)
 		if lhp > 0 {
-			if lep > lhp {
-				return nil, errors.New("range mismatch: ports > host ports")
-			}
-			if lep > 1 && lep < lhp {
-				return nil, errors.New("range mismatch: ports < host ports")
+			if lep > lhp || (lep > 1 && lep < lhp) {
+				errs = append(errs, model.NewError(model.Path{i}, &InvalidRangeError{
+					Port:      string(mfSP.Port),
+					HostPort:  string(mfSP.HostPort),
+					Ports:     lep,
+					HostPorts: lhp,
+				}))
+				continue
 			}
 		}
 		if lep == 1 {
@@ -228,5 +281,8 @@ func GenPorts(mfSPs []model.SrvPort) ([]module_lib.Port, error) {
 			}
 		}
 	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
 	return mPs, nil
 }
@@ -17,10 +17,12 @@
 package services
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/SENERGY-Platform/mgw-modfile-lib/imageref"
 	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
 	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
 )
@@ -504,9 +506,10 @@ func TestGenServices(t *testing.T) {
 	mfSs = make(map[string]model.Service)
 	str := "test"
 	str2 := "test2"
+	img := "test:v1"
 	mfSs[str] = model.Service{
 		Name:      str,
-		Image:     str2,
+		Image:     img,
 		RunConfig: model.RunConfig{},
 		Include: []model.BindMount{
 			{
@@ -541,7 +544,7 @@ func TestGenServices(t *testing.T) {
 	}
 	a := module_lib.Service{
 		Name:  str,
-		Image: str2,
+		Image: img,
 		RunConfig: module_lib.RunConfig{
 			StopTimeout: 5 * time.Second,
 			StopSignal:  "",
@@ -685,6 +688,72 @@ func TestGenServices(t *testing.T) {
 	if _, err := GenServices(mfSs); err == nil {
 		t.Error("err == nil")
 	}
+	// --------------------------------
+	mfSs = map[string]model.Service{
+		str: {
+			Include: []model.BindMount{
+				{
+					MountPoint: str,
+					Source:     "a",
+				},
+				{
+					MountPoint: str,
+					Source:     "b",
+				},
+			},
+			Ports: []model.SrvPort{
+				{
+					Port:     "80-82",
+					HostPort: "8080-8081",
+				},
+			},
+		},
+	}
+	_, err := GenServices(mfSs)
+	var dtErr *DuplicateTargetError
+	if !errors.As(err, &dtErr) {
+		t.Error("not *DuplicateTargetError")
+	} else if dtErr.Section != SectionInclude || dtErr.Service != str || dtErr.Existing != "a" || dtErr.Target != str {
+		t.Errorf("%+v", dtErr)
+	}
+	var irErr *InvalidRangeError
+	if !errors.As(err, &irErr) {
+		t.Error("not *InvalidRangeError")
+	} else if irErr.Service != str || irErr.Ports != 3 || irErr.HostPorts != 2 {
+		t.Errorf("%+v", irErr)
+	}
+	// --------------------------------
+	mfSs = map[string]model.Service{
+		str: {Image: "test"},
+	}
+	_, err = GenServices(mfSs)
+	var mErr *model.Error
+	if !errors.Is(err, imageref.ErrUntagged) {
+		t.Error("not ErrUntagged")
+	} else if !errors.As(err, &mErr) || mErr.Path.String() != "services.test.image" {
+		t.Error(err)
+	}
+}
+
+func TestCheckImageTags(t *testing.T) {
+	images := map[string]string{
+		"a": "test:v1",
+		"b": "test:latest",
+		"c": "test",
+	}
+	if err := CheckImageTags(images, nil); err != nil {
+		t.Error(err)
+	}
+	err := CheckImageTags(images, []string{"latest"})
+	var mErr *model.Error
+	if !errors.Is(err, imageref.ErrMutableTag) {
+		t.Error("not ErrMutableTag")
+	} else if !errors.As(err, &mErr) || mErr.Path.String() != "services.b.image" {
+		t.Error(err)
+	}
+	if l := len(err.(interface{ Unwrap() []error }).Unwrap()); l != 1 {
+		t.Errorf("%d != 1", l)
+	}
 }
 
 func TestGenAuxServices(t *testing.T) {
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package services
+
+import (
+	"cmp"
+	"errors"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+type mount struct {
+	target  string // cleaned mount point
+	section string
+	key     string
+	file    bool // true if the mount is a single file and can not contain other mounts
+}
+
+// CheckMountPoints checks the mount points of all mount kinds (bind mounts, tmpfs, volumes, host resources,
+// secrets, files and file groups) per service and aux service. Different mounts must not share a mount point and
+// must not be nested below a file mount (files and secret items). Nesting below directory mounts is allowed.
+func CheckMountPoints(mSs map[string]module_lib.Service, mAs map[string]module_lib.AuxService) error {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mSs) {
+		mS := mSs[ref]
+		var mounts []mount
+		mounts = appendMounts(mounts, mS.BindMounts, genBindMount)
+		mounts = appendMounts(mounts, mS.Tmpfs, genTmpfsMount)
+		mounts = appendMounts(mounts, mS.Volumes, func(v string) mount { return mount{section: SectionVolumes, key: v} })
+		mounts = appendMounts(mounts, mS.HostResources, func(v module_lib.HostResTarget) mount {
+			return mount{section: SectionHostResources, key: v.Ref}
+		})
+		mounts = appendMounts(mounts, mS.SecretMounts, func(v module_lib.SecretTarget) mount {
+			return mount{section: SectionSecrets, key: v.Ref, file: v.Item != ""}
+		})
+		mounts = appendMounts(mounts, mS.Files, func(v string) mount { return mount{section: SectionFiles, key: v, file: true} })
+		mounts = appendMounts(mounts, mS.FileGroups, func(v string) mount { return mount{section: SectionFileGroups, key: v} })
+		errs = append(errs, checkMounts(mounts, ref, false)...)
+	}
+	for _, ref := range maputil.SortedKeys(mAs) {
+		mA := mAs[ref]
+		var mounts []mount
+		mounts = appendMounts(mounts, mA.BindMounts, genBindMount)
+		mounts = appendMounts(mounts, mA.Tmpfs, genTmpfsMount)
+		mounts = appendMounts(mounts, mA.Volumes, func(v string) mount { return mount{section: SectionVolumes, key: v} })
+		errs = append(errs, checkMounts(mounts, ref, true)...)
+	}
+	return errors.Join(errs...)
+}
+
+func appendMounts[T any](mounts []mount, m map[string]T, gen func(T) mount) []mount {
+	for target, v := range m {
+		mnt := gen(v)
+		mnt.target = path.Clean(target)
+		mounts = append(mounts, mnt)
+	}
+	return mounts
+}
+
+func genBindMount(v module_lib.BindMount) mount {
+	return mount{section: SectionInclude, key: v.Source}
+}
+
+func genTmpfsMount(module_lib.TmpfsMount) mount {
+	return mount{section: SectionTmpfs}
+}
+
+func checkMounts(mounts []mount, ref string, aux bool) []error {
+	slices.SortFunc(mounts, func(a, b mount) int {
+		return cmp.Or(cmp.Compare(a.target, b.target), cmp.Compare(a.section, b.section), cmp.Compare(a.key, b.key))
+	})
+	var errs []error
+	for i, a := range mounts {
+		for _, b := range mounts[i+1:] {
+			if a.target == b.target || a.file && isNested(a.target, b.target) {
+				errs = append(errs, model.NewError(model.Path{srvSection(aux), ref}, &MountCollisionError{
+					Service:         ref,
+					Aux:             aux,
+					Target:          b.target,
+					Section:         b.section,
+					Key:             b.key,
+					ExistingTarget:  a.target,
+					ExistingSection: a.section,
+					ExistingKey:     a.key,
+				}))
+			}
+		}
+	}
+	return errs
+}
+
+func isNested(parent, target string) bool {
+	return parent == "/" || strings.HasPrefix(target, parent+"/")
+}
+
+func srvSection(aux bool) string {
+	if aux {
+		return "auxServices"
+	}
+	return "services"
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+func TestCheckMountPoints(t *testing.T) {
+	mSs := map[string]module_lib.Service{
+		"a": {
+			BindMounts:    map[string]module_lib.BindMount{"/opt/static": {Source: "static"}},
+			Tmpfs:         map[string]module_lib.TmpfsMount{"/tmp": {}},
+			Volumes:       map[string]string{"/data": "vol"},
+			HostResources: map[string]module_lib.HostResTarget{"/dev/ttyUSB0": {Ref: "usb"}},
+			SecretMounts:  map[string]module_lib.SecretTarget{"/run/secrets": {Ref: "crt"}},
+			Files:         map[string]string{"/data/config.json": "cfg"},
+			FileGroups:    map[string]string{"/opt/static/extra": "grp"},
+		},
+	}
+	mAs := map[string]module_lib.AuxService{
+		"b": {
+			Tmpfs:   map[string]module_lib.TmpfsMount{"/tmp": {}},
+			Volumes: map[string]string{"/data": "vol"},
+		},
+	}
+	if err := CheckMountPoints(mSs, mAs); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	mSs["a"] = module_lib.Service{
+		BindMounts: map[string]module_lib.BindMount{"/data/": {Source: "static"}},
+		Volumes:    map[string]string{"/data": "vol"},
+	}
+	err := CheckMountPoints(mSs, nil)
+	var mcErr *MountCollisionError
+	if !errors.As(err, &mcErr) {
+		t.Fatal("not *MountCollisionError")
+	}
+	if mcErr.Service != "a" || mcErr.Aux || mcErr.Target != "/data" || mcErr.Section != SectionVolumes || mcErr.Key != "vol" || mcErr.ExistingSection != SectionInclude || mcErr.ExistingKey != "static" {
+		t.Errorf("%+v", mcErr)
+	}
+	var mErr *model.Error
+	if !errors.As(err, &mErr) || mErr.Path.String() != "services.a" {
+		t.Error(err)
+	}
+	// ---------------------------
+	mSs["a"] = module_lib.Service{
+		SecretMounts: map[string]module_lib.SecretTarget{"/run/key": {Ref: "crt", Item: "key"}},
+		Files:        map[string]string{"/etc/app.conf": "cfg"},
+		Tmpfs:        map[string]module_lib.TmpfsMount{"/etc/app.conf/cache": {}},
+		Volumes:      map[string]string{"/run/key/data": "vol"},
+	}
+	err = CheckMountPoints(mSs, nil)
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors")
+	}
+	if l := len(je.Unwrap()); l != 2 {
+		t.Errorf("%d != 2", l)
+	}
+	for _, e := range je.Unwrap() {
+		if !errors.As(e, &mcErr) {
+			t.Fatal("not *MountCollisionError")
+		}
+		if mcErr.ExistingTarget == mcErr.Target {
+			t.Errorf("%+v", mcErr)
+		}
+	}
+	// ---------------------------
+	mAs["b"] = module_lib.AuxService{
+		Tmpfs:   map[string]module_lib.TmpfsMount{"/data": {}},
+		Volumes: map[string]string{"/data": "vol"},
+	}
+	err = CheckMountPoints(nil, mAs)
+	if !errors.As(err, &mcErr) {
+		t.Fatal("not *MountCollisionError")
+	}
+	if !mcErr.Aux || mcErr.Service != "b" {
+		t.Errorf("%+v", mcErr)
+	}
+	if s := mcErr.Error(); s != "aux service 'b' mount point collision: volume 'vol' at '/data' conflicts with tmpfs mount at '/data'" {
+		t.Error(s)
+	}
+}
@@ -17,19 +17,23 @@
 package services
 
 import (
-	"fmt"
+	"errors"
 
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
 	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
 	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
 )
 
 func SetSrvReferences(mfSRs map[string][]model.DependencyTarget, mSs map[string]module_lib.Service) error {
-	for ref, mfDTs := range mfSRs {
-		for _, mfDT := range mfDTs {
-			for _, tRef := range mfDT.Services {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mfSRs) {
+		mfDTs := mfSRs[ref]
+		for i, mfDT := range mfDTs {
+			for j, tRef := range mfDT.Services {
 				mS, ok := mSs[tRef]
 				if !ok {
-					return fmt.Errorf("invalid service reference: service '%s' not defined", tRef)
+					errs = append(errs, model.NewError(model.Path{SectionServiceReferences, ref, i, "services", j}, &UndefinedRefError{Section: SectionServiceReferences, Key: ref, Ref: tRef, Target: mfDT.RefVar}))
+					continue
 				}
 				if mS.SrvReferences == nil {
 					mS.SrvReferences = make(map[string]module_lib.SrvRefTarget)
@@ -38,7 +42,8 @@ func SetSrvReferences(mfSRs map[string][]model.DependencyTarget, mSs map[string]
 					if r.Ref == ref {
 						continue
 					}
-					return fmt.Errorf("service '%s' invalid service reference: duplicate '%s'", tRef, mfDT.RefVar)
+					errs = append(errs, model.NewError(model.Path{SectionServiceReferences, ref, i, "refVar"}, &DuplicateTargetError{Section: SectionServiceReferences, Key: ref, Existing: r.Ref, Service: tRef, Target: mfDT.RefVar}))
+					continue
 				}
 				mS.SrvReferences[mfDT.RefVar] = module_lib.SrvRefTarget{
 					Ref:      ref,
@@ -48,16 +53,19 @@ func SetSrvReferences(mfSRs map[string][]model.DependencyTarget, mSs map[string]
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetAuxSrvReferences(mfSRs map[string][]model.DependencyTarget, mAs map[string]module_lib.AuxService) error {
-	for ref, mfDTs := range mfSRs {
-		for _, mfDT := range mfDTs {
-			for _, tRef := range mfDT.AuxServices {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mfSRs) {
+		mfDTs := mfSRs[ref]
+		for i, mfDT := range mfDTs {
+			for j, tRef := range mfDT.AuxServices {
 				mA, ok := mAs[tRef]
 				if !ok {
-					return fmt.Errorf("invalid service reference: aux service '%s' not defined", tRef)
+					errs = append(errs, model.NewError(model.Path{SectionServiceReferences, ref, i, "auxServices", j}, &UndefinedRefError{Section: SectionServiceReferences, Key: ref, Ref: tRef, Aux: true, Target: mfDT.RefVar}))
+					continue
 				}
 				if mA.SrvReferences == nil {
 					mA.SrvReferences = make(map[string]module_lib.SrvRefTarget)
@@ -66,7 +74,8 @@ func SetAuxSrvReferences(mfSRs map[string][]model.DependencyTarget, mAs map[stri
 					if r.Ref == ref {
 						continue
 					}
-					return fmt.Errorf("aux service '%s' invalid service reference: duplicate '%s'", tRef, mfDT.RefVar)
+					errs = append(errs, model.NewError(model.Path{SectionServiceReferences, ref, i, "refVar"}, &DuplicateTargetError{Section: SectionServiceReferences, Key: ref, Existing: r.Ref, Service: tRef, Aux: true, Target: mfDT.RefVar}))
+					continue
 				}
 				mA.SrvReferences[mfDT.RefVar] = module_lib.SrvRefTarget{
 					Ref:      ref,
@@ -76,16 +85,19 @@ func SetAuxSrvReferences(mfSRs map[string][]model.DependencyTarget, mAs map[stri
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetVolumes(mfVs map[string][]model.VolumeTarget, mSs map[string]module_lib.Service) error {
-	for mfV, mfVTs := range mfVs {
-		for _, mfVT := range mfVTs {
-			for _, ref := range mfVT.Services {
+	var errs []error
+	for _, mfV := range maputil.SortedKeys(mfVs) {
+		mfVTs := mfVs[mfV]
+		for i, mfVT := range mfVTs {
+			for j, ref := range mfVT.Services {
 				mS, ok := mSs[ref]
 				if !ok {
-					return fmt.Errorf("invalid volume: service '%s' not defined", ref)
+					errs = append(errs, model.NewError(model.Path{SectionVolumes, mfV, i, "services", j}, &UndefinedRefError{Section: SectionVolumes, Key: mfV, Ref: ref, Target: mfVT.MountPoint}))
+					continue
 				}
 				if mS.Volumes == nil {
 					mS.Volumes = make(map[string]string)
@@ -94,23 +106,27 @@ func SetVolumes(mfVs map[string][]model.VolumeTarget, mSs map[string]module_lib.
 					if v == mfV {
 						continue
 					}
-					return fmt.Errorf("service '%s' invalid volume: duplicate '%s'", ref, mfVT.MountPoint)
+					errs = append(errs, model.NewError(model.Path{SectionVolumes, mfV, i, "mountPoint"}, &DuplicateTargetError{Section: SectionVolumes, Key: mfV, Existing: v, Service: ref, Target: mfVT.MountPoint}))
+					continue
 				}
 				mS.Volumes[mfVT.MountPoint] = mfV
 				mSs[ref] = mS
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetAuxVolumes(mfVs map[string][]model.VolumeTarget, mAs map[string]module_lib.AuxService) error {
-	for mfV, mfVTs := range mfVs {
-		for _, mfVT := range mfVTs {
-			for _, ref := range mfVT.AuxServices {
+	var errs []error
+	for _, mfV := range maputil.SortedKeys(mfVs) {
+		mfVTs := mfVs[mfV]
+		for i, mfVT := range mfVTs {
+			for j, ref := range mfVT.AuxServices {
 				mA, ok := mAs[ref]
 				if !ok {
-					return fmt.Errorf("invalid volume: aux service '%s' not defined", ref)
+					errs = append(errs, model.NewError(model.Path{SectionVolumes, mfV, i, "auxServices", j}, &UndefinedRefError{Section: SectionVolumes, Key: mfV, Ref: ref, Aux: true, Target: mfVT.MountPoint}))
+					continue
 				}
 				if mA.Volumes == nil {
 					mA.Volumes = make(map[string]string)
@@ -119,24 +135,29 @@ func SetAuxVolumes(mfVs map[string][]model.VolumeTarget, mAs map[string]module_l
 					if v == mfV {
 						continue
 					}
-					return fmt.Errorf("aux service '%s' invalid volume: duplicate '%s'", ref, mfVT.MountPoint)
+					errs = append(errs, model.NewError(model.Path{SectionVolumes, mfV, i, "mountPoint"}, &DuplicateTargetError{Section: SectionVolumes, Key: mfV, Existing: v, Service: ref, Aux: true, Target: mfVT.MountPoint}))
+					continue
 				}
 				mA.Volumes[mfVT.MountPoint] = mfV
 				mAs[ref] = mA
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetExtDependencies(mfMDs map[string]model.ModuleDependency, mSs map[string]module_lib.Service) error {
-	for extId, mfMD := range mfMDs {
-		for extRef, mfDTs := range mfMD.RequiredServices {
-			for _, mfDT := range mfDTs {
-				for _, ref := range mfDT.Services {
+	var errs []error
+	for _, extId := range maputil.SortedKeys(mfMDs) {
+		mfMD := mfMDs[extId]
+		for _, extRef := range maputil.SortedKeys(mfMD.RequiredServices) {
+			mfDTs := mfMD.RequiredServices[extRef]
+			for i, mfDT := range mfDTs {
+				for j, ref := range mfDT.Services {
 					mS, ok := mSs[ref]
 					if !ok {
-						return fmt.Errorf("invalid module dependency: service '%s' not defined", ref)
+						errs = append(errs, model.NewError(model.Path{SectionDependencies, extId, "requiredServices", extRef, i, "services", j}, &UndefinedRefError{Section: SectionDependencies, Key: extId, Ref: ref, Target: mfDT.RefVar}))
+						continue
 					}
 					if mS.ExtDependencies == nil {
 						mS.ExtDependencies = make(map[string]module_lib.ExtDependencyTarget)
@@ -145,7 +166,8 @@ func SetExtDependencies(mfMDs map[string]model.ModuleDependency, mSs map[string]
 						if etd.ID == extId && etd.Service == extRef {
 							continue
 						}
-						return fmt.Errorf("service '%s' invalid module dependency: duplicate '%s'", ref, mfDT.RefVar)
+						errs = append(errs, model.NewError(model.Path{SectionDependencies, extId, "requiredServices", extRef, i, "refVar"}, &DuplicateTargetError{Section: SectionDependencies, Key: extId, Existing: etd.ID, ExistingService: etd.Service, Service: ref, Target: mfDT.RefVar}))
+						continue
 					}
 					mS.ExtDependencies[mfDT.RefVar] = module_lib.ExtDependencyTarget{
 						ID:       extId,
@@ -157,17 +179,21 @@ func SetExtDependencies(mfMDs map[string]model.ModuleDependency, mSs map[string]
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetAuxExtDependencies(mfMDs map[string]model.ModuleDependency, mAs map[string]module_lib.AuxService) error {
-	for extId, mfMD := range mfMDs {
-		for extRef, mfDTs := range mfMD.RequiredServices {
-			for _, mfDT := range mfDTs {
-				for _, ref := range mfDT.AuxServices {
+	var errs []error
+	for _, extId := range maputil.SortedKeys(mfMDs) {
+		mfMD := mfMDs[extId]
+		for _, extRef := range maputil.SortedKeys(mfMD.RequiredServices) {
+			mfDTs := mfMD.RequiredServices[extRef]
+			for i, mfDT := range mfDTs {
+				for j, ref := range mfDT.AuxServices {
 					mA, ok := mAs[ref]
 					if !ok {
-						return fmt.Errorf("invalid module dependency: aux service '%s' not defined", ref)
+						errs = append(errs, model.NewError(model.Path{SectionDependencies, extId, "requiredServices", extRef, i, "auxServices", j}, &UndefinedRefError{Section: SectionDependencies, Key: extId, Ref: ref, Aux: true, Target: mfDT.RefVar}))
+						continue
 					}
 					if mA.ExtDependencies == nil {
 						mA.ExtDependencies = make(map[string]module_lib.ExtDependencyTarget)
@@ -176,7 +202,8 @@ func SetAuxExtDependencies(mfMDs map[string]model.ModuleDependency, mAs map[stri
 						if etd.ID == extId && etd.Service == extRef {
 							continue
 						}
-						return fmt.Errorf("aux service '%s' invalid module dependency: duplicate '%s'", ref, mfDT.RefVar)
+						errs = append(errs, model.NewError(model.Path{SectionDependencies, extId, "requiredServices", extRef, i, "refVar"}, &DuplicateTargetError{Section: SectionDependencies, Key: extId, Existing: etd.ID, ExistingService: etd.Service, Service: ref, Aux: true, Target: mfDT.RefVar}))
+						continue
 					}
 					mA.ExtDependencies[mfDT.RefVar] = module_lib.ExtDependencyTarget{
 						ID:       extId,
@@ -188,16 +215,19 @@ func SetAuxExtDependencies(mfMDs map[string]model.ModuleDependency, mAs map[stri
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetHostResources(mfRs map[string]model.HostResource, mSs map[string]module_lib.Service) error {
-	for rRef, mfR := range mfRs {
-		for _, mfRT := range mfR.Targets {
-			for _, sRef := range mfRT.Services {
+	var errs []error
+	for _, rRef := range maputil.SortedKeys(mfRs) {
+		mfR := mfRs[rRef]
+		for i, mfRT := range mfR.Targets {
+			for j, sRef := range mfRT.Services {
 				mS, ok := mSs[sRef]
 				if !ok {
-					return fmt.Errorf("invalid resource: service '%s' not defined", sRef)
+					errs = append(errs, model.NewError(model.Path{SectionHostResources, rRef, "targets", i, "services", j}, &UndefinedRefError{Section: SectionHostResources, Key: rRef, Ref: sRef, Target: mfRT.MountPoint}))
+					continue
 				}
 				if mS.HostResources == nil {
 					mS.HostResources = make(map[string]module_lib.HostResTarget)
@@ -206,7 +236,8 @@ func SetHostResources(mfRs map[string]model.HostResource, mSs map[string]module_
 					if mRT.Ref == rRef && mRT.ReadOnly == mfRT.ReadOnly {
 						continue
 					}
-					return fmt.Errorf("'%s' & '%s' -> '%s' -> '%s'", mRT.Ref, rRef, sRef, mfRT.MountPoint)
+					errs = append(errs, model.NewError(model.Path{SectionHostResources, rRef, "targets", i, "mountPoint"}, &DuplicateTargetError{Section: SectionHostResources, Key: rRef, Existing: mRT.Ref, Service: sRef, Target: mfRT.MountPoint}))
+					continue
 				}
 				mS.HostResources[mfRT.MountPoint] = module_lib.HostResTarget{
 					Ref:      rRef,
@@ -216,16 +247,19 @@ func SetHostResources(mfRs map[string]model.HostResource, mSs map[string]module_
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetFiles(mfFiles map[string]model.File, mSs map[string]module_lib.Service) error {
-	for fRef, file := range mfFiles {
-		for _, target := range file.Targets {
-			for _, sRef := range target.Services {
+	var errs []error
+	for _, fRef := range maputil.SortedKeys(mfFiles) {
+		file := mfFiles[fRef]
+		for i, target := range file.Targets {
+			for j, sRef := range target.Services {
 				mS, ok := mSs[sRef]
 				if !ok {
-					return fmt.Errorf("invalid file: service '%s' not defined", sRef)
+					errs = append(errs, model.NewError(model.Path{SectionFiles, fRef, "targets", i, "services", j}, &UndefinedRefError{Section: SectionFiles, Key: fRef, Ref: sRef, Target: target.MountPoint}))
+					continue
 				}
 				if mS.Files == nil {
 					mS.Files = make(map[string]string)
@@ -234,23 +268,27 @@ func SetFiles(mfFiles map[string]model.File, mSs map[string]module_lib.Service)
 					if r == fRef {
 						continue
 					}
-					return fmt.Errorf("'%s' & '%s' -> '%s' -> '%s'", r, fRef, sRef, target.MountPoint)
+					errs = append(errs, model.NewError(model.Path{SectionFiles, fRef, "targets", i, "mountPoint"}, &DuplicateTargetError{Section: SectionFiles, Key: fRef, Existing: r, Service: sRef, Target: target.MountPoint}))
+					continue
 				}
 				mS.Files[target.MountPoint] = fRef
 				mSs[sRef] = mS
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetFileGroups(mfFileGroups map[string]model.FileGroup, mSs map[string]module_lib.Service) error {
-	for gRef, fileGroup := range mfFileGroups {
-		for _, target := range fileGroup.Targets {
-			for _, sRef := range target.Services {
+	var errs []error
+	for _, gRef := range maputil.SortedKeys(mfFileGroups) {
+		fileGroup := mfFileGroups[gRef]
+		for i, target := range fileGroup.Targets {
+			for j, sRef := range target.Services {
 				mS, ok := mSs[sRef]
 				if !ok {
-					return fmt.Errorf("invalid file group: service '%s' not defined", sRef)
+					errs = append(errs, model.NewError(model.Path{SectionFileGroups, gRef, "targets", i, "services", j}, &UndefinedRefError{Section: SectionFileGroups, Key: gRef, Ref: sRef, Target: target.BasePath}))
+					continue
 				}
 				if mS.FileGroups == nil {
 					mS.FileGroups = make(map[string]string)
@@ -259,24 +297,28 @@ func SetFileGroups(mfFileGroups map[string]model.FileGroup, mSs map[string]modul
 					if r == gRef {
 						continue
 					}
-					return fmt.Errorf("'%s' & '%s' -> '%s' -> '%s'", r, gRef, sRef, target.BasePath)
+					errs = append(errs, model.NewError(model.Path{SectionFileGroups, gRef, "targets", i, "basePath"}, &DuplicateTargetError{Section: SectionFileGroups, Key: gRef, Existing: r, Service: sRef, Target: target.BasePath}))
+					continue
 				}
 				mS.FileGroups[target.BasePath] = gRef
 				mSs[sRef] = mS
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetSecrets(mfSecrets map[string]model.Secret, mServices map[string]module_lib.Service) error {
-	for secRef, mfSecret := range mfSecrets {
-		for _, mfSecretTarget := range mfSecret.Targets {
+	var errs []error
+	for _, secRef := range maputil.SortedKeys(mfSecrets) {
+		mfSecret := mfSecrets[secRef]
+		for i, mfSecretTarget := range mfSecret.Targets {
 			if mfSecretTarget.MountPoint != "" {
-				for _, mfSrvRef := range mfSecretTarget.Services {
+				for j, mfSrvRef := range mfSecretTarget.Services {
 					mService, ok := mServices[mfSrvRef]
 					if !ok {
-						return fmt.Errorf("invalid secret: service '%s' not defined", mfSrvRef)
+						errs = append(errs, model.NewError(model.Path{SectionSecrets, secRef, "targets", i, "services", j}, &UndefinedRefError{Section: SectionSecrets, Key: secRef, Ref: mfSrvRef, Target: mfSecretTarget.MountPoint}))
+						continue
 					}
 					if mService.SecretMounts == nil {
 						mService.SecretMounts = make(map[string]module_lib.SecretTarget)
@@ -285,7 +327,8 @@ func SetSecrets(mfSecrets map[string]model.Secret, mServices map[string]module_l
 						if mSecretTarget.Ref == secRef {
 							continue
 						}
-						return fmt.Errorf("'%s' & '%s' -> '%s' -> '%s'", mSecretTarget.Ref, secRef, mfSrvRef, mfSecretTarget.MountPoint)
+						errs = append(errs, model.NewError(model.Path{SectionSecrets, secRef, "targets", i, "mountPoint"}, &DuplicateTargetError{Section: SectionSecrets, Key: secRef, Existing: mSecretTarget.Ref, Service: mfSrvRef, Target: mfSecretTarget.MountPoint}))
+						continue
 					}
 					mService.SecretMounts[mfSecretTarget.MountPoint] = module_lib.SecretTarget{
 						Ref:  secRef,
@@ -295,10 +338,11 @@ func SetSecrets(mfSecrets map[string]model.Secret, mServices map[string]module_l
 				}
 			}
 			if mfSecretTarget.RefVar != "" {
-				for _, mfSrvRef := range mfSecretTarget.Services {
+				for j, mfSrvRef := range mfSecretTarget.Services {
 					mService, ok := mServices[mfSrvRef]
 					if !ok {
-						return fmt.Errorf("invalid secret: service '%s' not defined", mfSrvRef)
+						errs = append(errs, model.NewError(model.Path{SectionSecrets, secRef, "targets", i, "services", j}, &UndefinedRefError{Section: SectionSecrets, Key: secRef, Ref: mfSrvRef, Target: mfSecretTarget.RefVar}))
+						continue
 					}
 					if mService.SecretVars == nil {
 						mService.SecretVars = make(map[string]module_lib.SecretTarget)
@@ -307,7 +351,8 @@ func SetSecrets(mfSecrets map[string]model.Secret, mServices map[string]module_l
 						if mSecretTarget.Ref == secRef {
 							continue
 						}
-						return fmt.Errorf("'%s' & '%s' -> '%s' -> '%s'", mSecretTarget.Ref, secRef, mfSrvRef, mfSecretTarget.RefVar)
+						errs = append(errs, model.NewError(model.Path{SectionSecrets, secRef, "targets", i, "refVar"}, &DuplicateTargetError{Section: SectionSecrets, Key: secRef, Existing: mSecretTarget.Ref, Service: mfSrvRef, Target: mfSecretTarget.RefVar}))
+						continue
 					}
 					mService.SecretVars[mfSecretTarget.RefVar] = module_lib.SecretTarget{
 						Ref:  secRef,
@@ -318,16 +363,19 @@ func SetSecrets(mfSecrets map[string]model.Secret, mServices map[string]module_l
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetConfigs(mfCVs map[string]model.ConfigValue, mSs map[string]module_lib.Service) error {
-	for cRef, mfCV := range mfCVs {
-		for _, mfCT := range mfCV.Targets {
-			for _, sRef := range mfCT.Services {
+	var errs []error
+	for _, cRef := range maputil.SortedKeys(mfCVs) {
+		mfCV := mfCVs[cRef]
+		for i, mfCT := range mfCV.Targets {
+			for j, sRef := range mfCT.Services {
 				mS, ok := mSs[sRef]
 				if !ok {
-					return fmt.Errorf("invalid config: service '%s' not defined", sRef)
+					errs = append(errs, model.NewError(model.Path{SectionConfigs, cRef, "targets", i, "services", j}, &UndefinedRefError{Section: SectionConfigs, Key: cRef, Ref: sRef, Target: mfCT.RefVar}))
+					continue
 				}
 				if mS.Configs == nil {
 					mS.Configs = make(map[string]string)
@@ -336,23 +384,27 @@ func SetConfigs(mfCVs map[string]model.ConfigValue, mSs map[string]module_lib.Se
 					if r == cRef {
 						continue
 					}
-					return fmt.Errorf("'%s' & '%s' -> '%s' -> '%s'", r, cRef, sRef, mfCT.RefVar)
+					errs = append(errs, model.NewError(model.Path{SectionConfigs, cRef, "targets", i, "refVar"}, &DuplicateTargetError{Section: SectionConfigs, Key: cRef, Existing: r, Service: sRef, Target: mfCT.RefVar}))
+					continue
 				}
 				mS.Configs[mfCT.RefVar] = cRef
 				mSs[sRef] = mS
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SetAuxConfigs(mfCVs map[string]model.ConfigValue, mAs map[string]module_lib.AuxService) error {
-	for cRef, mfCV := range mfCVs {
-		for _, mfCT := range mfCV.Targets {
-			for _, sRef := range mfCT.AuxServices {
+	var errs []error
+	for _, cRef := range maputil.SortedKeys(mfCVs) {
+		mfCV := mfCVs[cRef]
+		for i, mfCT := range mfCV.Targets {
+			for j, sRef := range mfCT.AuxServices {
 				mA, ok := mAs[sRef]
 				if !ok {
-					return fmt.Errorf("invalid config: aux service '%s' not defined", sRef)
+					errs = append(errs, model.NewError(model.Path{SectionConfigs, cRef, "targets", i, "auxServices", j}, &UndefinedRefError{Section: SectionConfigs, Key: cRef, Ref: sRef, Aux: true, Target: mfCT.RefVar}))
+					continue
 				}
 				if mA.Configs == nil {
 					mA.Configs = make(map[string]string)
@@ -361,12 +413,13 @@ func SetAuxConfigs(mfCVs map[string]model.ConfigValue, mAs map[string]module_lib
 					if r == cRef {
 						continue
 					}
-					return fmt.Errorf("'%s' & '%s' -> '%s' -> '%s'", r, cRef, sRef, mfCT.RefVar)
+					errs = append(errs, model.NewError(model.Path{SectionConfigs, cRef, "targets", i, "refVar"}, &DuplicateTargetError{Section: SectionConfigs, Key: cRef, Existing: r, Service: sRef, Aux: true, Target: mfCT.RefVar}))
+					continue
 				}
 				mA.Configs[mfCT.RefVar] = cRef
 				mAs[sRef] = mA
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
@@ -17,6 +17,7 @@
 package services
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -158,6 +159,52 @@ func TestSetVolumes(t *testing.T) {
 	if err := SetVolumes(mfVs, mSs); err == nil {
 		t.Error("err != nil")
 	}
+	// --------------------------------
+	mfVs = map[string][]model.VolumeTarget{
+		vl: {
+			{
+				MountPoint: mp,
+				Services:   []string{"b", "c"},
+			},
+		},
+	}
+	if err := SetVolumes(mfVs, mSs); err == nil {
+		t.Error("err == nil")
+	} else if e, ok := err.(interface{ Unwrap() []error }); !ok {
+		t.Error("not a joined error")
+	} else if l := len(e.Unwrap()); l != 2 {
+		t.Errorf("%d != 2", l)
+	}
+	// --------------------------------
+	mfVs = map[string][]model.VolumeTarget{
+		vl: {
+			{
+				MountPoint: mp,
+				Services:   []string{"b"},
+			},
+		},
+	}
+	var urErr *UndefinedRefError
+	if err := SetVolumes(mfVs, mSs); !errors.As(err, &urErr) {
+		t.Error("not *UndefinedRefError")
+	} else if urErr.Section != SectionVolumes || urErr.Key != vl || urErr.Ref != "b" || urErr.Aux || urErr.Target != mp {
+		t.Errorf("%+v", urErr)
+	}
+	// --------------------------------
+	mfVs = map[string][]model.VolumeTarget{
+		"vl2": {
+			{
+				MountPoint: mp,
+				Services:   []string{sRef},
+			},
+		},
+	}
+	var dtErr *DuplicateTargetError
+	if err := SetVolumes(mfVs, mSs); !errors.As(err, &dtErr) {
+		t.Error("not *DuplicateTargetError")
+	} else if dtErr.Section != SectionVolumes || dtErr.Key != "vl2" || dtErr.Existing != vl || dtErr.Service != sRef || dtErr.Target != mp {
+		t.Errorf("%+v", dtErr)
+	}
 }
 
 func TestSetExtDependencies(t *testing.T) {
@@ -237,8 +284,11 @@ func TestSetExtDependencies(t *testing.T) {
 			},
 		},
 	}
-	if err := SetExtDependencies(mfMDs, mSs); err == nil {
-		t.Error("err == nil")
+	var dtErr *DuplicateTargetError
+	if err := SetExtDependencies(mfMDs, mSs); !errors.As(err, &dtErr) {
+		t.Error("not *DuplicateTargetError")
+	} else if dtErr.Key != mID || dtErr.Existing != mID || dtErr.ExistingService != dRef || dtErr.Target != rVar {
+		t.Errorf("%+v", dtErr)
 	}
 	// --------------------------------
 	mfMDs[mID] = model.ModuleDependency{
@@ -252,8 +302,11 @@ func TestSetExtDependencies(t *testing.T) {
 			},
 		},
 	}
-	if err := SetExtDependencies(mfMDs, mSs); err == nil {
-		t.Error("err == nil")
+	var urErr *UndefinedRefError
+	if err := SetExtDependencies(mfMDs, mSs); !errors.As(err, &urErr) {
+		t.Error("not *UndefinedRefError")
+	} else if urErr.Key != mID || urErr.Ref != "c" || urErr.Target != rVar {
+		t.Errorf("%+v", urErr)
 	}
 	// --------------------------------
 	mfMDs[mID] = model.ModuleDependency{
@@ -549,3 +602,53 @@ func TestSetConfigs(t *testing.T) {
 		t.Error("err == nil")
 	}
 }
+
+func TestSetUndefinedRefTargets(t *testing.T) {
+	dt := []model.DependencyTarget{{RefVar: "var", Services: []string{"x"}, AuxServices: []string{"x"}}}
+	tests := []struct {
+		set    func(mSs map[string]module_lib.Service, mAs map[string]module_lib.AuxService) error
+		target string
+	}{
+		{func(mSs map[string]module_lib.Service, _ map[string]module_lib.AuxService) error {
+			return SetSrvReferences(map[string][]model.DependencyTarget{"a": dt}, mSs)
+		}, "var"},
+		{func(_ map[string]module_lib.Service, mAs map[string]module_lib.AuxService) error {
+			return SetAuxSrvReferences(map[string][]model.DependencyTarget{"a": dt}, mAs)
+		}, "var"},
+		{func(_ map[string]module_lib.Service, mAs map[string]module_lib.AuxService) error {
+			return SetAuxVolumes(map[string][]model.VolumeTarget{"a": {{MountPoint: "/mnt", AuxServices: []string{"x"}}}}, mAs)
+		}, "/mnt"},
+		{func(_ map[string]module_lib.Service, mAs map[string]module_lib.AuxService) error {
+			return SetAuxExtDependencies(map[string]model.ModuleDependency{"a": {RequiredServices: map[string][]model.DependencyTarget{"b": dt}}}, mAs)
+		}, "var"},
+		{func(mSs map[string]module_lib.Service, _ map[string]module_lib.AuxService) error {
+			return SetHostResources(map[string]model.HostResource{"a": {Targets: []model.HostResourceTarget{{MountPoint: "/dev", Services: []string{"x"}}}}}, mSs)
+		}, "/dev"},
+		{func(mSs map[string]module_lib.Service, _ map[string]module_lib.AuxService) error {
+			return SetFiles(map[string]model.File{"a": {Targets: []model.FileTarget{{MountPoint: "/file", Services: []string{"x"}}}}}, mSs)
+		}, "/file"},
+		{func(mSs map[string]module_lib.Service, _ map[string]module_lib.AuxService) error {
+			return SetFileGroups(map[string]model.FileGroup{"a": {Targets: []model.FileGroupTarget{{BasePath: "/files", Services: []string{"x"}}}}}, mSs)
+		}, "/files"},
+		{func(mSs map[string]module_lib.Service, _ map[string]module_lib.AuxService) error {
+			return SetSecrets(map[string]model.Secret{"a": {Targets: []model.SecretTarget{{MountPoint: "/secret", Services: []string{"x"}}}}}, mSs)
+		}, "/secret"},
+		{func(mSs map[string]module_lib.Service, _ map[string]module_lib.AuxService) error {
+			return SetSecrets(map[string]model.Secret{"a": {Targets: []model.SecretTarget{{RefVar: "SECRET", Services: []string{"x"}}}}}, mSs)
+		}, "SECRET"},
+		{func(mSs map[string]module_lib.Service, _ map[string]module_lib.AuxService) error {
+			return SetConfigs(map[string]model.ConfigValue{"a": {Targets: []model.ConfigTarget{{RefVar: "CFG", Services: []string{"x"}}}}}, mSs)
+		}, "CFG"},
+		{func(_ map[string]module_lib.Service, mAs map[string]module_lib.AuxService) error {
+			return SetAuxConfigs(map[string]model.ConfigValue{"a": {Targets: []model.ConfigTarget{{RefVar: "CFG", AuxServices: []string{"x"}}}}}, mAs)
+		}, "CFG"},
+	}
+	for i, tc := range tests {
+		var urErr *UndefinedRefError
+		if err := tc.set(map[string]module_lib.Service{}, map[string]module_lib.AuxService{}); !errors.As(err, &urErr) {
+			t.Errorf("%d: not *UndefinedRefError", i)
+		} else if urErr.Target != tc.target {
+			t.Errorf("%d: %s != %s", i, urErr.Target, tc.target)
+		}
+	}
+}
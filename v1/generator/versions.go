@@ -0,0 +1,41 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/semver"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+)
+
+// CheckVersions checks that the module version is a semantic version and that all dependency versions
+// are valid constraints, see semver.ParseVersion and semver.ParseConstraint.
+func CheckVersions(version string, dependencies map[string]string) error {
+	var errs []error
+	if _, err := semver.ParseVersion(version); err != nil {
+		errs = append(errs, model.NewError(model.Path{"version"}, err))
+	}
+	for _, id := range maputil.SortedKeys(dependencies) {
+		constraint := dependencies[id]
+		if _, err := semver.ParseConstraint(constraint); err != nil {
+			errs = append(errs, model.NewError(model.Path{"dependencies", id, "version"}, err))
+		}
+	}
+	return errors.Join(errs...)
+}
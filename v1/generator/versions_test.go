@@ -0,0 +1,47 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+)
+
+func TestCheckVersions(t *testing.T) {
+	if err := CheckVersions("v1.0.0", map[string]string{"a": ">=v1.0.0;<v2.0.0", "b": "v1.2.3"}); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	err := CheckVersions("1.0.0", map[string]string{"a": ">=v1.0.0", "b": "latest"})
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors")
+	}
+	paths := make(map[string]bool)
+	for _, e := range je.Unwrap() {
+		var mErr *model.Error
+		if !errors.As(e, &mErr) {
+			t.Fatal("not *Error")
+		}
+		paths[mErr.Path.String()] = true
+	}
+	if len(paths) != 2 || !paths["version"] || !paths["dependencies.b.version"] {
+		t.Errorf("%v", paths)
+	}
+}
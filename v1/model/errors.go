@@ -0,0 +1,323 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Path identifies an element of a modfile, items are map keys (string) or sequence indices (int).
+type Path []any
+
+func (p Path) String() string {
+	var b strings.Builder
+	for _, item := range p {
+		switch v := item.(type) {
+		case int:
+			b.WriteString("[" + strconv.FormatInt(int64(v), 10) + "]")
+		case string:
+			if pathKeyRe.MatchString(v) {
+				if b.Len() > 0 {
+					b.WriteString(".")
+				}
+				b.WriteString(v)
+			} else {
+				b.WriteString("[" + strconv.Quote(v) + "]")
+			}
+		default:
+			b.WriteString(fmt.Sprintf("[%v]", v))
+		}
+	}
+	return b.String()
+}
+
+// Node returns the node identified by the path or nil if the path does not exist.
+func (p Path) Node(yn *yaml.Node) *yaml.Node {
+	yn = resolveNode(yn)
+	for _, item := range p {
+		if yn == nil {
+			return nil
+		}
+		switch v := item.(type) {
+		case int:
+			if yn.Kind != yaml.SequenceNode || v < 0 || v >= len(yn.Content) {
+				return nil
+			}
+			yn = resolveNode(yn.Content[v])
+		case string:
+			if yn.Kind != yaml.MappingNode {
+				return nil
+			}
+			var next *yaml.Node
+			for i := 0; i+1 < len(yn.Content); i += 2 {
+				if yn.Content[i].Value == v {
+					next = resolveNode(yn.Content[i+1])
+					break
+				}
+			}
+			yn = next
+		default:
+			return nil
+		}
+	}
+	return yn
+}
+
+// Error describes a problem with an element of a modfile. The path as well as the line and column are
+// set if known and can be used to locate the element in the document. File is only set if the element
+// is located in an included file, see ResolveIncludes.
+type Error struct {
+	Path   Path
+	File   string
+	Line   int
+	Column int
+	Err    error
+	node   *yaml.Node
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		b.WriteString(e.File + ": ")
+	}
+	if e.Line > 0 {
+		b.WriteString(fmt.Sprintf("line %d, column %d: ", e.Line, e.Column))
+	}
+	if len(e.Path) > 0 {
+		b.WriteString(e.Path.String() + ": ")
+	}
+	b.WriteString(e.Err.Error())
+	return b.String()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func NewError(path Path, err error) *Error {
+	return &Error{Path: path, Err: err}
+}
+
+func newNodeError(yn *yaml.Node, err error) *Error {
+	return &Error{Line: yn.Line, Column: yn.Column, Err: err, node: yn}
+}
+
+// WrapErrors prefixes the paths of all errors contained in err and wraps their messages using format, which
+// must contain a single %w verb placed after the verbs consumed by args. Errors without a path are wrapped as a whole.
+func WrapErrors(err error, prefix Path, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	if je, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+		for _, e := range je.Unwrap() {
+			errs = append(errs, WrapErrors(e, prefix, format, args...))
+		}
+		return errors.Join(errs...)
+	}
+	if e, ok := err.(*Error); ok {
+		return &Error{
+			Path:   append(slices.Clone(prefix), e.Path...),
+			File:   e.File,
+			Line:   e.Line,
+			Column: e.Column,
+			Err:    fmt.Errorf(format, append(args, e.Err)...),
+			node:   e.node,
+		}
+	}
+	return &Error{Path: slices.Clone(prefix), Err: fmt.Errorf(format, append(args, err)...)}
+}
+
+var typeErrRe = regexp.MustCompile(`^line (\d+): (.*)$`)
+
+// Locate sets missing paths, lines and columns of all errors contained in err by looking up the respective
+// elements in yn. The returned error contains the errors ordered by their position in the document.
+func Locate(err error, yn *yaml.Node) error {
+	return locate(err, yn, nil)
+}
+
+func locate(err error, yn *yaml.Node, files Includes) error {
+	if err == nil {
+		return nil
+	}
+	errs := flatten(err)
+	for i, e := range errs {
+		var te *yaml.TypeError
+		if errors.As(e, &te) {
+			var tErrs []error
+			for _, msg := range te.Errors {
+				tErrs = append(tErrs, locateTypeError(msg, yn, files))
+			}
+			errs[i] = errors.Join(tErrs...)
+			continue
+		}
+		var me *Error
+		if !errors.As(e, &me) {
+			continue
+		}
+		if me.Path == nil && me.node != nil {
+			me.Path = findPath(yn, me.node, nil)
+		}
+		if me.Line == 0 && me.Path != nil {
+			if n := me.Path.Node(yn); n != nil {
+				me.Line = n.Line
+				me.Column = n.Column
+				me.node = n
+			}
+		}
+		if me.File == "" && me.node != nil {
+			me.File = files[me.node]
+		}
+	}
+	errs = flatten(errors.Join(errs...))
+	slices.SortStableFunc(errs, comparePositions)
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+func locateTypeError(msg string, yn *yaml.Node, files Includes) error {
+	m := typeErrRe.FindStringSubmatch(msg)
+	if m == nil {
+		return errors.New(msg)
+	}
+	line, _ := strconv.Atoi(m[1])
+	e := &Error{Line: line, Err: errors.New(m[2])}
+	if n, p := findLine(yn, line, nil); n != nil {
+		e.Path = p
+		e.File = files[n]
+		e.Column = n.Column
+	}
+	return e
+}
+
+// comparePositions orders errors by file, with the including document first, followed by line and column.
+// Errors without a line are placed last.
+func comparePositions(a, b error) int {
+	ea, eb := positioned(a), positioned(b)
+	if ea == nil || eb == nil {
+		switch {
+		case ea != nil:
+			return -1
+		case eb != nil:
+			return 1
+		}
+		return 0
+	}
+	return cmp.Or(strings.Compare(ea.File, eb.File), ea.Line-eb.Line, ea.Column-eb.Column)
+}
+
+func positioned(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) && e.Line > 0 {
+		return e
+	}
+	return nil
+}
+
+func flatten(err error) []error {
+	if je, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+		for _, e := range je.Unwrap() {
+			errs = append(errs, flatten(e)...)
+		}
+		return errs
+	}
+	return []error{err}
+}
+
+func findPath(yn *yaml.Node, target *yaml.Node, path Path) Path {
+	if yn == target {
+		if path == nil {
+			return Path{}
+		}
+		return path
+	}
+	switch yn.Kind {
+	case yaml.DocumentNode:
+		for _, c := range yn.Content {
+			if p := findPath(c, target, path); p != nil {
+				return p
+			}
+		}
+	case yaml.SequenceNode:
+		for i, c := range yn.Content {
+			if p := findPath(c, target, append(slices.Clone(path), i)); p != nil {
+				return p
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(yn.Content); i += 2 {
+			if p := findPath(yn.Content[i+1], target, append(slices.Clone(path), yn.Content[i].Value)); p != nil {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+func findLine(yn *yaml.Node, line int, path Path) (*yaml.Node, Path) {
+	switch yn.Kind {
+	case yaml.DocumentNode:
+		for _, c := range yn.Content {
+			if n, p := findLine(c, line, path); n != nil {
+				return n, p
+			}
+		}
+	case yaml.SequenceNode:
+		for i, c := range yn.Content {
+			if n, p := findLine(c, line, append(slices.Clone(path), i)); n != nil {
+				return n, p
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(yn.Content); i += 2 {
+			if n, p := findLine(yn.Content[i+1], line, append(slices.Clone(path), yn.Content[i].Value)); n != nil {
+				return n, p
+			}
+		}
+	case yaml.ScalarNode:
+		if yn.Line == line {
+			return yn, path
+		}
+	}
+	return nil, nil
+}
+
+func resolveNode(yn *yaml.Node) *yaml.Node {
+	for yn != nil && (yn.Kind == yaml.DocumentNode || yn.Kind == yaml.AliasNode) {
+		if yn.Kind == yaml.AliasNode {
+			yn = yn.Alias
+		} else if len(yn.Content) > 0 {
+			yn = yn.Content[0]
+		} else {
+			return nil
+		}
+	}
+	return yn
+}
+
+var pathKeyRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
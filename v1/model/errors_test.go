@@ -0,0 +1,132 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const testErrDoc = `a:
+  b:
+    - x
+    - "y"
+  c.d: z
+`
+
+func TestPath_String(t *testing.T) {
+	if s := (Path{"services", "api", "ports", 2}).String(); s != "services.api.ports[2]" {
+		t.Errorf("%s != services.api.ports[2]", s)
+	}
+	// ---------------------------
+	if s := (Path{"configs", "a.b"}).String(); s != `configs["a.b"]` {
+		t.Errorf(`%s != configs["a.b"]`, s)
+	}
+	// ---------------------------
+	if s := (Path{}).String(); s != "" {
+		t.Errorf("%s != \"\"", s)
+	}
+}
+
+func TestPath_Node(t *testing.T) {
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(testErrDoc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	if n := (Path{"a", "b", 1}).Node(&yn); n == nil {
+		t.Error("n == nil")
+	} else if n.Value != "y" || n.Line != 4 || n.Column != 7 {
+		t.Errorf("%s %d %d", n.Value, n.Line, n.Column)
+	}
+	// ---------------------------
+	if n := (Path{"a", "c.d"}).Node(&yn); n == nil || n.Value != "z" {
+		t.Error("n == nil || n.Value != z")
+	}
+	// ---------------------------
+	if n := (Path{"a", "b", 2}).Node(&yn); n != nil {
+		t.Error("n != nil")
+	}
+	// ---------------------------
+	if n := (Path{"a", "x"}).Node(&yn); n != nil {
+		t.Error("n != nil")
+	}
+}
+
+func TestLocate(t *testing.T) {
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(testErrDoc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	err := Locate(errors.Join(
+		WrapErrors(NewError(Path{1}, errors.New("test")), Path{"a", "b"}, "%s: %w", "b"),
+		NewError(Path{"a", "c.d"}, errors.New("test")),
+		newNodeError(Path{"a", "b", 0}.Node(&yn), errors.New("test")),
+		errors.New("test"),
+	), &yn)
+	errs := flatten(err)
+	if len(errs) != 4 {
+		t.Fatalf("%d != 4", len(errs))
+	}
+	a := []struct {
+		path   string
+		line   int
+		column int
+	}{
+		{"a.b[0]", 3, 7},
+		{"a.b[1]", 4, 7},
+		{`a["c.d"]`, 5, 8},
+	}
+	for i, b := range a {
+		var e *Error
+		if !errors.As(errs[i], &e) {
+			t.Errorf("%d: not *Error", i)
+			continue
+		}
+		if e.Path.String() != b.path || e.Line != b.line || e.Column != b.column {
+			t.Errorf("%d: %s %d %d", i, e.Path, e.Line, e.Column)
+		}
+	}
+	if errs[1].Error() != "line 4, column 7: a.b[1]: b: test" {
+		t.Error(errs[1].Error())
+	}
+	var e *Error
+	if errors.As(errs[3], &e) {
+		t.Error("errors.As(errs[3], &e)")
+	}
+	// ---------------------------
+	var x struct {
+		A struct {
+			B []int `yaml:"b"`
+		} `yaml:"a"`
+	}
+	err = Locate(yn.Decode(&x), &yn)
+	errs = flatten(err)
+	if len(errs) != 2 {
+		t.Fatalf("%d != 2", len(errs))
+	}
+	if !errors.As(errs[0], &e) {
+		t.Error("not *Error")
+	} else if e.Path.String() != "a.b[0]" || e.Line != 3 || e.Column != 7 {
+		t.Errorf("%s %d %d", e.Path, e.Line, e.Column)
+	}
+	// ---------------------------
+	if Locate(nil, &yn) != nil {
+		t.Error("err != nil")
+	}
+}
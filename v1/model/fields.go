@@ -0,0 +1,154 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnknownFieldError indicates a mapping key that is not defined by the modfile model.
+type UnknownFieldError struct {
+	Field string
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field '%s'", e.Field)
+}
+
+var unmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+
+// CheckFields reports every mapping key in yn that does not correspond to a field of ModFile. Each key is
+// returned as *Error wrapping an *UnknownFieldError, positioned at the key. Nodes shared via aliases are checked once.
+func CheckFields(yn *yaml.Node) error {
+	return CheckModelFields(yn, ModFile{})
+}
+
+// CheckModelFields is like CheckFields but checks against the model v, e.g. of another modfile version.
+func CheckModelFields(yn *yaml.Node, v any) error {
+	return joinErrors(checkFields(yn, reflect.TypeOf(v), Path{}, make(map[*yaml.Node]bool)))
+}
+
+func checkFields(yn *yaml.Node, t reflect.Type, path Path, seen map[*yaml.Node]bool) []error {
+	yn = resolveNode(yn)
+	if yn == nil || seen[yn] {
+		return nil
+	}
+	seen[yn] = true
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if reflect.PointerTo(t).Implements(unmarshalerType) {
+		return nil
+	}
+	var errs []error
+	switch t.Kind() {
+	case reflect.Struct:
+		if yn.Kind != yaml.MappingNode {
+			return nil
+		}
+		fields := structFields(t)
+		for i := 0; i+1 < len(yn.Content); i += 2 {
+			k, v := yn.Content[i], yn.Content[i+1]
+			if k.Tag == "!!merge" {
+				errs = append(errs, checkMerge(v, t, path, seen)...)
+				continue
+			}
+			ft, ok := fields[k.Value]
+			if !ok {
+				errs = append(errs, &Error{
+					Path:   append(slices.Clone(path), k.Value),
+					Line:   k.Line,
+					Column: k.Column,
+					Err:    &UnknownFieldError{Field: k.Value},
+				})
+				continue
+			}
+			errs = append(errs, checkFields(v, ft, append(slices.Clone(path), k.Value), seen)...)
+		}
+	case reflect.Map:
+		if yn.Kind != yaml.MappingNode {
+			return nil
+		}
+		for i := 0; i+1 < len(yn.Content); i += 2 {
+			errs = append(errs, checkFields(yn.Content[i+1], t.Elem(), append(slices.Clone(path), yn.Content[i].Value), seen)...)
+		}
+	case reflect.Slice:
+		if yn.Kind != yaml.SequenceNode {
+			return nil
+		}
+		for i, c := range yn.Content {
+			errs = append(errs, checkFields(c, t.Elem(), append(slices.Clone(path), i), seen)...)
+		}
+	}
+	return errs
+}
+
+func checkMerge(yn *yaml.Node, t reflect.Type, path Path, seen map[*yaml.Node]bool) []error {
+	yn = resolveNode(yn)
+	if yn == nil {
+		return nil
+	}
+	if yn.Kind == yaml.SequenceNode {
+		var errs []error
+		for _, c := range yn.Content {
+			errs = append(errs, checkFields(c, t, path, seen)...)
+		}
+		return errs
+	}
+	return checkFields(yn, t, path, seen)
+}
+
+func structFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		if slices.Contains(strings.Split(opts, ","), "inline") {
+			for n, ft := range structFields(f.Type) {
+				fields[n] = ft
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+func joinErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
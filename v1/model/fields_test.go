@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestCheckFields(t *testing.T) {
+	doc := `modfileVersion: v1
+name: test
+services:
+  a: &srv
+    name: A
+    image: test:v1
+    ports:
+      - port: 80
+        hostPorts: 8080
+    include:
+      - mountPoint: /a
+        source: a
+        readonly: true
+  b:
+    <<: *srv
+    nme: B
+configs:
+  c:
+    value: 1
+    userInput:
+      type: text
+      name: C
+      typeOptions:
+        x: 1
+`
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	err := Locate(CheckFields(&yn), &yn)
+	if err == nil {
+		t.Fatal("err == nil")
+	}
+	a := []struct {
+		path   string
+		line   int
+		column int
+	}{
+		{"services.a.ports[0].hostPorts", 9, 9},
+		{"services.a.include[0].readonly", 13, 9},
+		{"services.b.nme", 16, 5},
+	}
+	errs := flatten(err)
+	if len(errs) != len(a) {
+		t.Fatalf("%d != %d", len(errs), len(a))
+	}
+	for i, b := range a {
+		var e *Error
+		var ufErr *UnknownFieldError
+		if !errors.As(errs[i], &e) || !errors.As(errs[i], &ufErr) {
+			t.Errorf("%d: not *Error / *UnknownFieldError", i)
+			continue
+		}
+		if e.Path.String() != b.path || e.Line != b.line || e.Column != b.column {
+			t.Errorf("%d: %s %d %d", i, e.Path, e.Line, e.Column)
+		}
+	}
+	// ---------------------------
+	if err := yaml.Unmarshal([]byte("modfileVersion: v1\nname: test\n"), &yn); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckFields(&yn); err != nil {
+		t.Error(err)
+	}
+}
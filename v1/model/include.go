@@ -0,0 +1,198 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IncludeTag marks a node whose content is read from a file, e.g. 'configs: !include configs.yml'.
+// Paths are relative to the directory of the including file.
+const IncludeTag = "!include"
+
+// Includes maps nodes added by ResolveIncludes to the names of the files they were read from.
+type Includes map[*yaml.Node]string
+
+// ResolveIncludes replaces all nodes tagged with IncludeTag by the content of the referenced files.
+// Included files may contain further includes. Paths must not be absolute or leave fsys and
+// cycles are reported as errors. The name of the file containing yn is optional.
+func ResolveIncludes(yn *yaml.Node, fsys fs.FS, name string) (Includes, error) {
+	return ResolveIncludesWithLimits(yn, fsys, name, IncludeLimits{})
+}
+
+// IncludeLimits bounds the resolution of includes, a limit of zero disables the respective check.
+// As files may be included any number of times, the limits apply to the whole resolution.
+type IncludeLimits struct {
+	// MaxFileSize limits the size of each included file in bytes.
+	MaxFileSize int64
+	// MaxSize limits the total number of bytes read from included files.
+	MaxSize int64
+	// MaxIncludes limits the number of resolved includes.
+	MaxIncludes int
+	// MaxNodes limits the number of nodes of the document with includes resolved, aliases are not expanded.
+	MaxNodes int
+	// MaxDepth limits the nesting depth of the document with includes resolved, aliases are not expanded.
+	MaxDepth int
+}
+
+// ResolveIncludesWithLimits is like ResolveIncludes but stops with a *LimitError once a limit is exceeded.
+// Limits are checked while resolving, so files are not read after a limit has been exceeded.
+func ResolveIncludesWithLimits(yn *yaml.Node, fsys fs.FS, name string, limits IncludeLimits) (Includes, error) {
+	r := includer{
+		fsys:   fsys,
+		limits: limits,
+		files:  make(Includes),
+	}
+	if name != "" {
+		r.stack = append(r.stack, name)
+	}
+	r.resolve(yn, name, 0)
+	if len(r.files) == 0 {
+		r.files = nil
+	}
+	return r.files, joinErrors(r.errs)
+}
+
+// Locate is like the package level Locate function but also sets the file of errors located in included files.
+func (inc Includes) Locate(err error, yn *yaml.Node) error {
+	return locate(err, yn, inc)
+}
+
+type includer struct {
+	fsys     fs.FS
+	limits   IncludeLimits
+	files    Includes
+	stack    []string
+	errs     []error
+	includes int
+	size     int64
+	nodes    int
+	exceeded bool
+}
+
+// resolve visits yn, depth is the nesting depth of the parent of yn.
+func (r *includer) resolve(yn *yaml.Node, file string, depth int) {
+	if r.exceeded {
+		return
+	}
+	if file != "" {
+		r.files[yn] = file
+	}
+	if yn.Tag == IncludeTag {
+		r.include(yn, file, depth)
+		return
+	}
+	if yn.Kind == yaml.AliasNode {
+		return
+	}
+	if yn.Kind != yaml.DocumentNode {
+		depth++
+		r.nodes++
+		if r.limits.MaxNodes > 0 && r.nodes > r.limits.MaxNodes {
+			r.exceed(yn, file, LimitNodes, int64(r.limits.MaxNodes))
+			return
+		}
+		if r.limits.MaxDepth > 0 && depth > r.limits.MaxDepth {
+			r.exceed(yn, file, LimitDepth, int64(r.limits.MaxDepth))
+			return
+		}
+	}
+	for _, c := range yn.Content {
+		r.resolve(c, file, depth)
+	}
+}
+
+func (r *includer) include(yn *yaml.Node, file string, depth int) {
+	if yn.Kind != yaml.ScalarNode || yn.Value == "" {
+		r.errs = append(r.errs, r.newError(yn, file, errors.New("invalid include: file path required")))
+		return
+	}
+	if r.fsys == nil {
+		r.errs = append(r.errs, r.newError(yn, file, fmt.Errorf("include '%s': no file system provided", yn.Value)))
+		return
+	}
+	name, err := RepoPath(path.Dir(file), yn.Value)
+	if err != nil {
+		r.errs = append(r.errs, r.newError(yn, file, fmt.Errorf("include '%s': %w", yn.Value, err)))
+		return
+	}
+	if slices.Contains(r.stack, name) {
+		r.errs = append(r.errs, r.newError(yn, file, fmt.Errorf("include '%s': cycle: %s", yn.Value, strings.Join(append(r.stack, name), " -> "))))
+		return
+	}
+	r.includes++
+	if r.limits.MaxIncludes > 0 && r.includes > r.limits.MaxIncludes {
+		r.exceed(yn, file, LimitIncludes, int64(r.limits.MaxIncludes))
+		return
+	}
+	b, err := ReadFile(r.fsys, name, r.limits.MaxFileSize)
+	if err != nil {
+		r.errs = append(r.errs, r.newError(yn, file, fmt.Errorf("include '%s': %w", yn.Value, err)))
+		return
+	}
+	r.size += int64(len(b))
+	if r.limits.MaxSize > 0 && r.size > r.limits.MaxSize {
+		r.exceed(yn, file, LimitIncludeSize, r.limits.MaxSize)
+		return
+	}
+	var doc yaml.Node
+	if err = yaml.Unmarshal(b, &doc); err != nil {
+		r.errs = append(r.errs, newSyntaxError(name, err))
+		return
+	}
+	root := resolveNode(&doc)
+	if root == nil || root.Kind == 0 {
+		r.errs = append(r.errs, r.newError(yn, file, fmt.Errorf("include '%s': empty file", yn.Value)))
+		return
+	}
+	r.stack = append(r.stack, name)
+	r.resolve(root, name, depth)
+	r.stack = r.stack[:len(r.stack)-1]
+	*yn = *root
+	r.files[yn] = name
+}
+
+// exceed stops the resolution.
+func (r *includer) exceed(yn *yaml.Node, file, limit string, max int64) {
+	r.errs = append(r.errs, r.newError(yn, file, &LimitError{Limit: limit, Max: max}))
+	r.exceeded = true
+}
+
+func (r *includer) newError(yn *yaml.Node, file string, err error) *Error {
+	e := newNodeError(yn, err)
+	e.File = file
+	return e
+}
+
+var syntaxErrRe = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+func newSyntaxError(file string, err error) *Error {
+	if m := syntaxErrRe.FindStringSubmatch(err.Error()); m != nil {
+		line, _ := strconv.Atoi(m[1])
+		return &Error{File: file, Line: line, Err: errors.New(m[2])}
+	}
+	return &Error{File: file, Err: err}
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"gopkg.in/yaml.v3"
+)
+
+func resolveTestDoc(t *testing.T, doc string, fsys fs.FS) (*yaml.Node, Includes, error) {
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	inc, err := ResolveIncludes(&yn, fsys, "")
+	return &yn, inc, err
+}
+
+func TestResolveIncludes(t *testing.T) {
+	fsys := fstest.MapFS{
+		"configs.yml":      {Data: []byte("a: !include values/a.yml\nb: 2\n")},
+		"values/a.yml":     {Data: []byte("- 1\n- !include ../b.yml\n")},
+		"b.yml":            {Data: []byte("x\n")},
+		"cycle/a.yml":      {Data: []byte("a: !include b.yml\n")},
+		"cycle/b.yml":      {Data: []byte("b: !include a.yml\n")},
+		"invalid.yml":      {Data: []byte("a: [\n")},
+		"values/empty.yml": {Data: []byte("")},
+	}
+	t.Run("valid", func(t *testing.T) {
+		yn, inc, err := resolveTestDoc(t, "configs: !include configs.yml\n", fsys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v map[string]map[string]any
+		if err = yn.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		if a, ok := v["configs"]["a"].([]any); !ok || len(a) != 2 || a[0] != 1 || a[1] != "x" {
+			t.Errorf("%v", v)
+		}
+		if v["configs"]["b"] != 2 {
+			t.Errorf("%v", v)
+		}
+		if f := inc[Path{"configs", "a", 0}.Node(yn)]; f != "values/a.yml" {
+			t.Errorf("%s != values/a.yml", f)
+		}
+		if f := inc[Path{"configs", "a", 1}.Node(yn)]; f != "b.yml" {
+			t.Errorf("%s != b.yml", f)
+		}
+	})
+	t.Run("no includes", func(t *testing.T) {
+		if _, inc, err := resolveTestDoc(t, "a: 1\n", nil); err != nil {
+			t.Error(err)
+		} else if inc != nil {
+			t.Error("inc != nil")
+		}
+	})
+	tests := map[string]struct {
+		doc  string
+		msg  string
+		file string
+		line int
+	}{
+		"cycle":      {"a: !include cycle/a.yml\n", "cycle: cycle/a.yml -> cycle/b.yml -> cycle/a.yml", "cycle/b.yml", 1},
+		"traversal":  {"a: !include ../configs.yml\n", "path outside of repository", "", 1},
+		"absolute":   {"a: !include /configs.yml\n", "invalid path", "", 1},
+		"missing":    {"a: 1\nb: !include missing.yml\n", "file does not exist", "", 2},
+		"syntax":     {"a: !include invalid.yml\n", "did not find expected node content", "invalid.yml", 1},
+		"empty":      {"a: !include values/empty.yml\n", "empty file", "", 1},
+		"no path":    {"a: !include\n", "file path required", "", 1},
+		"non scalar": {"a: !include [a.yml]\n", "file path required", "", 1},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			yn, inc, err := resolveTestDoc(t, tc.doc, fsys)
+			if err == nil {
+				t.Fatal("err == nil")
+			}
+			err = inc.Locate(err, yn)
+			var e *Error
+			if !errors.As(err, &e) {
+				t.Fatal("expected Error")
+			}
+			if !strings.Contains(e.Error(), tc.msg) {
+				t.Errorf("'%s' does not contain '%s'", e, tc.msg)
+			}
+			if e.File != tc.file {
+				t.Errorf("%s != %s", e.File, tc.file)
+			}
+			if e.Line != tc.line {
+				t.Errorf("%d != %d", e.Line, tc.line)
+			}
+		})
+	}
+	t.Run("no file system", func(t *testing.T) {
+		if _, _, err := resolveTestDoc(t, "a: !include configs.yml\n", nil); err == nil {
+			t.Error("err == nil")
+		}
+	})
+}
+
+func TestIncludes_Locate(t *testing.T) {
+	fsys := fstest.MapFS{
+		"configs.yml": {Data: []byte("a:\n  b: 1\n")},
+	}
+	yn, inc, err := resolveTestDoc(t, "x: 1\nconfigs: !include configs.yml\n", fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = inc.Locate(errors.Join(
+		NewError(Path{"configs", "a", "b"}, errors.New("test")),
+		NewError(Path{"x"}, errors.New("test")),
+	), yn)
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors")
+	}
+	errs := je.Unwrap()
+	if len(errs) != 2 {
+		t.Fatalf("%d != 2", len(errs))
+	}
+	if s := errs[0].Error(); s != "line 1, column 4: x: test" {
+		t.Errorf("%s != line 1, column 4: x: test", s)
+	}
+	if s := errs[1].Error(); s != "configs.yml: line 2, column 6: configs.a.b: test" {
+		t.Errorf("%s != configs.yml: line 2, column 6: configs.a.b: test", s)
+	}
+}
+
+func TestResolveIncludesWithLimits(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.yml": {Data: []byte("[!include b.yml, !include b.yml]\n")},
+		"b.yml": {Data: []byte("[!include c.yml, !include c.yml]\n")},
+		"c.yml": {Data: []byte("[!include d.yml, !include d.yml]\n")},
+		"d.yml": {Data: []byte("[1, 2, 3, 4, 5, 6, 7, 8]\n")},
+	}
+	resolve := func(limits IncludeLimits) (*yaml.Node, Includes, error) {
+		var yn yaml.Node
+		if err := yaml.Unmarshal([]byte("a: !include a.yml\n"), &yn); err != nil {
+			t.Fatal(err)
+		}
+		inc, err := ResolveIncludesWithLimits(&yn, fsys, "", limits)
+		return &yn, inc, err
+	}
+	if _, _, err := resolve(IncludeLimits{MaxFileSize: 64, MaxSize: 1024, MaxIncludes: 15, MaxNodes: 81, MaxDepth: 6}); err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		limits IncludeLimits
+		limit  string
+	}{
+		"file size": {IncludeLimits{MaxFileSize: 32}, LimitSize},
+		"size":      {IncludeLimits{MaxSize: 256}, LimitIncludeSize},
+		"includes":  {IncludeLimits{MaxIncludes: 14}, LimitIncludes},
+		"nodes":     {IncludeLimits{MaxNodes: 80}, LimitNodes},
+		"depth":     {IncludeLimits{MaxDepth: 5}, LimitDepth},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			yn, inc, err := resolve(tc.limits)
+			var lErr *LimitError
+			if !errors.As(err, &lErr) {
+				t.Fatal("not *LimitError")
+			}
+			if lErr.Limit != tc.limit {
+				t.Errorf("%s != %s", lErr.Limit, tc.limit)
+			}
+			var e *Error
+			if !errors.As(inc.Locate(err, yn), &e) || e.Line == 0 {
+				t.Error("not located")
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UndefinedVariableError indicates a variable reference without a value or default.
+type UndefinedVariableError struct {
+	Name string
+}
+
+func (e *UndefinedVariableError) Error() string {
+	return fmt.Sprintf("undefined variable '%s'", e.Name)
+}
+
+var varNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Interpolate expands variable references in all scalar values of yn using vars. References have the form
+// ${VAR} or ${VAR:-default}, the default is used if VAR is not set or empty. Defaults are not expanded and must not
+// contain references. A literal $ is written as $$.
+// Mapping keys are not expanded and plain scalars are resolved again, e.g. '${PORT}' can result in an integer.
+// Each invalid or undefined reference is returned as *Error.
+func Interpolate(yn *yaml.Node, vars map[string]string) error {
+	var errs []error
+	interpolate(yn, vars, &errs)
+	return joinErrors(errs)
+}
+
+func interpolate(yn *yaml.Node, vars map[string]string, errs *[]error) {
+	switch yn.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, c := range yn.Content {
+			interpolate(c, vars, errs)
+		}
+	case yaml.MappingNode:
+		for i := 1; i < len(yn.Content); i += 2 {
+			interpolate(yn.Content[i], vars, errs)
+		}
+	case yaml.ScalarNode:
+		if !strings.Contains(yn.Value, "$") {
+			return
+		}
+		s, eErrs := expand(yn.Value, vars)
+		if len(eErrs) > 0 {
+			for _, err := range eErrs {
+				*errs = append(*errs, newNodeError(yn, err))
+			}
+			return
+		}
+		if yn.Style == 0 && s != yn.Value {
+			yn.Tag = ""
+		}
+		yn.Value = s
+	}
+}
+
+func expand(s string, vars map[string]string) (string, []error) {
+	var b strings.Builder
+	var errs []error
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '$':
+			b.WriteByte('$')
+			i++
+		case '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				return "", append(errs, fmt.Errorf("invalid variable reference '%s'", s[i:]))
+			}
+			ref := s[i+2 : i+2+end]
+			name, def, hasDef := strings.Cut(ref, ":-")
+			if !varNameRe.MatchString(name) {
+				errs = append(errs, fmt.Errorf("invalid variable reference '${%s}'", ref))
+			} else if strings.Contains(def, "${") {
+				errs = append(errs, fmt.Errorf("invalid variable reference '${%s:-...}': nested references are not supported", name))
+			} else if v, ok := vars[name]; ok && (v != "" || !hasDef) {
+				b.WriteString(v)
+			} else if hasDef {
+				b.WriteString(def)
+			} else {
+				errs = append(errs, &UndefinedVariableError{Name: name})
+			}
+			i += 2 + end
+		default:
+			b.WriteByte('$')
+		}
+	}
+	return b.String(), errs
+}
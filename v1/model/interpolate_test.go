@@ -0,0 +1,119 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestInterpolate(t *testing.T) {
+	vars := map[string]string{
+		"REGISTRY": "ghcr.io",
+		"VERSION":  "v1.0.0",
+		"PORT":     "8080",
+		"EMPTY":    "",
+	}
+	doc := `image: ${REGISTRY}/test:${VERSION}
+port: ${PORT}
+quoted: "${PORT}"
+default: ${UNSET:-x}
+empty: ${EMPTY:-y}
+emptyNoDefault: a${EMPTY}b
+escaped: $${VERSION}
+price: 5$
+${KEY}: test
+`
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	if err := Interpolate(&yn, vars); err != nil {
+		t.Fatal(err)
+	}
+	var v map[string]any
+	if err := yn.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	a := map[string]any{
+		"image":          "ghcr.io/test:v1.0.0",
+		"port":           8080,
+		"quoted":         "8080",
+		"default":        "x",
+		"empty":          "y",
+		"emptyNoDefault": "ab",
+		"escaped":        "${VERSION}",
+		"price":          "5$",
+		"${KEY}":         "test",
+	}
+	for key, val := range a {
+		if v[key] != val {
+			t.Errorf("%s: %v != %v", key, v[key], val)
+		}
+	}
+}
+
+func TestInterpolateErrors(t *testing.T) {
+	doc := `a: ${A}
+b:
+  - ${B} ${C}
+c: ${C
+d: ${1D}
+e: ${A:-${B}}
+`
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	err := Locate(Interpolate(&yn, nil), &yn)
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors")
+	}
+	errs := je.Unwrap()
+	if len(errs) != 6 {
+		t.Fatalf("%d != 6", len(errs))
+	}
+	var uvErr *UndefinedVariableError
+	for i, name := range []string{"A", "B", "C"} {
+		if !errors.As(errs[i], &uvErr) {
+			t.Errorf("%d not *UndefinedVariableError", i)
+		} else if uvErr.Name != name {
+			t.Errorf("%s != %s", uvErr.Name, name)
+		}
+	}
+	var e *Error
+	if !errors.As(errs[2], &e) {
+		t.Fatal("not *Error")
+	}
+	if e.Path.String() != "b[0]" || e.Line != 3 || e.Column != 5 {
+		t.Errorf("%s %d %d", e.Path, e.Line, e.Column)
+	}
+	for _, err = range errs[3:] {
+		if errors.As(err, &uvErr) {
+			t.Error("unexpected *UndefinedVariableError")
+		}
+	}
+	if !errors.As(errs[5], &e) {
+		t.Fatal("not *Error")
+	}
+	if e.Path.String() != "e" {
+		t.Errorf("%s != e", e.Path)
+	}
+}
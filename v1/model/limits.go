@@ -0,0 +1,170 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	LimitSize        = "size"
+	LimitNodes       = "nodes"
+	LimitDepth       = "depth"
+	LimitPorts       = "ports"
+	LimitIncludes    = "includes"
+	LimitIncludeSize = "includeSize"
+)
+
+// LimitError indicates that a modfile exceeds a configured limit. Err optionally holds a more specific error.
+type LimitError struct {
+	Limit string
+	Max   int64
+	Err   error
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s limit of %d exceeded", e.Limit, e.Max)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
+
+// CheckLimits checks the number of nodes and the nesting depth of yn with aliases expanded. Aliased nodes
+// are only visited once, so expansion attacks are detected without expanding them. A limit of zero disables the check.
+func CheckLimits(yn *yaml.Node, maxNodes, maxDepth int) error {
+	if maxNodes <= 0 && maxDepth <= 0 {
+		return nil
+	}
+	c := limitChecker{stats: make(map[*yaml.Node]*nodeStats)}
+	s, err := c.check(yn)
+	if err != nil {
+		return err
+	}
+	if maxNodes > 0 && s.nodes > maxNodes {
+		return &LimitError{Limit: LimitNodes, Max: int64(maxNodes)}
+	}
+	if maxDepth > 0 && s.depth > maxDepth {
+		return &LimitError{Limit: LimitDepth, Max: int64(maxDepth)}
+	}
+	return nil
+}
+
+// CountPorts returns the number of container and host ports with ranges expanded. Invalid ports are ignored.
+func CountPorts(ports []SrvPort) int {
+	var n int
+	for _, port := range ports {
+		if c, err := port.Port.Count(); err == nil {
+			n += c
+		}
+		if port.HostPort != "" {
+			if c, err := port.HostPort.Count(); err == nil {
+				n += c
+			}
+		}
+	}
+	return n
+}
+
+// CheckPorts checks the number of container and host ports of all services in yn with ranges expanded, see CountPorts.
+// The check works on the document, so it applies before the modfile is decoded and regardless of its version. The error
+// is located at the ports of the service exceeding the limit. A limit of zero disables the check.
+func CheckPorts(yn *yaml.Node, maxPorts int) error {
+	if maxPorts <= 0 {
+		return nil
+	}
+	services := Path{"services"}.Node(yn)
+	if services == nil || services.Kind != yaml.MappingNode {
+		return nil
+	}
+	var n int
+	for i := 1; i < len(services.Content); i += 2 {
+		ports := Path{"ports"}.Node(services.Content[i])
+		if ports == nil || ports.Kind != yaml.SequenceNode {
+			continue
+		}
+		var srvPorts []SrvPort
+		for _, pn := range ports.Content {
+			var port SrvPort
+			if err := pn.Decode(&port); err == nil {
+				srvPorts = append(srvPorts, port)
+			}
+		}
+		n = addSaturated(n, CountPorts(srvPorts))
+		if n > maxPorts {
+			return newNodeError(ports, &LimitError{Limit: LimitPorts, Max: int64(maxPorts)})
+		}
+	}
+	return nil
+}
+
+type nodeStats struct {
+	nodes int
+	depth int
+	done  bool
+}
+
+type limitChecker struct {
+	stats map[*yaml.Node]*nodeStats
+}
+
+func (c *limitChecker) check(yn *yaml.Node) (*nodeStats, error) {
+	if s, ok := c.stats[yn]; ok {
+		if !s.done {
+			return nil, newNodeError(yn, errors.New("recursive alias"))
+		}
+		return s, nil
+	}
+	s := &nodeStats{}
+	c.stats[yn] = s
+	if yn.Kind == yaml.AliasNode {
+		if yn.Alias != nil {
+			as, err := c.check(yn.Alias)
+			if err != nil {
+				return nil, err
+			}
+			*s = *as
+		}
+		s.done = true
+		return s, nil
+	}
+	for _, child := range yn.Content {
+		cs, err := c.check(child)
+		if err != nil {
+			return nil, err
+		}
+		s.nodes = addSaturated(s.nodes, cs.nodes)
+		s.depth = max(s.depth, cs.depth)
+	}
+	if yn.Kind != yaml.DocumentNode {
+		s.nodes = addSaturated(s.nodes, 1)
+		s.depth++
+	}
+	s.done = true
+	return s, nil
+}
+
+func addSaturated(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const testLaughsDoc = `a: &a ["lol","lol","lol","lol","lol","lol","lol","lol","lol"]
+b: &b [*a,*a,*a,*a,*a,*a,*a,*a,*a]
+c: &c [*b,*b,*b,*b,*b,*b,*b,*b,*b]
+d: &d [*c,*c,*c,*c,*c,*c,*c,*c,*c]
+e: &e [*d,*d,*d,*d,*d,*d,*d,*d,*d]
+f: &f [*e,*e,*e,*e,*e,*e,*e,*e,*e]
+g: &g [*f,*f,*f,*f,*f,*f,*f,*f,*f]
+h: &h [*g,*g,*g,*g,*g,*g,*g,*g,*g]
+i: &i [*h,*h,*h,*h,*h,*h,*h,*h,*h]
+`
+
+func TestCheckLimits(t *testing.T) {
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(testLaughsDoc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckLimits(&yn, 0, 0); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	var lErr *LimitError
+	if err := CheckLimits(&yn, 10000, 0); !errors.As(err, &lErr) {
+		t.Error("not *LimitError")
+	} else if lErr.Limit != LimitNodes || lErr.Max != 10000 {
+		t.Errorf("%s %d", lErr.Limit, lErr.Max)
+	}
+	// ---------------------------
+	if err := CheckLimits(&yn, 0, 11); err != nil {
+		t.Error(err)
+	}
+	if err := CheckLimits(&yn, 0, 10); !errors.As(err, &lErr) {
+		t.Error("not *LimitError")
+	} else if lErr.Limit != LimitDepth || lErr.Max != 10 {
+		t.Errorf("%s %d", lErr.Limit, lErr.Max)
+	}
+	// ---------------------------
+	if err := yaml.Unmarshal([]byte("a: [1, 2]\nb: {c: 1}\n"), &yn); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckLimits(&yn, 9, 3); err != nil {
+		t.Error(err)
+	}
+	if err := CheckLimits(&yn, 8, 0); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestCountPorts(t *testing.T) {
+	ports := []SrvPort{
+		{Port: "80"},
+		{Port: "8080-8081", HostPort: "9080-9081"},
+		{Port: "invalid"},
+	}
+	if n := CountPorts(ports); n != 5 {
+		t.Errorf("%d != 5", n)
+	}
+}
+
+func TestCheckPorts(t *testing.T) {
+	var yn yaml.Node
+	doc := "services:\n  a:\n    ports:\n      - port: 8080-8081\n  b:\n    ports: &p\n      - port: 80\n        hostPort: 8000\n  c:\n    ports: *p\n"
+	if err := yaml.Unmarshal([]byte(doc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPorts(&yn, 0); err != nil {
+		t.Error(err)
+	}
+	if err := CheckPorts(&yn, 6); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	err := Locate(CheckPorts(&yn, 3), &yn)
+	var lErr *LimitError
+	var mErr *Error
+	if !errors.As(err, &lErr) || !errors.As(err, &mErr) {
+		t.Fatal("not *LimitError / *Error")
+	}
+	if lErr.Limit != LimitPorts || mErr.Path.String() != "services.b.ports" || mErr.Line != 6 {
+		t.Errorf("%s %s %d", lErr.Limit, mErr.Path, mErr.Line)
+	}
+	// ---------------------------
+	if err = CheckPorts(&yn, 5); !errors.As(err, &lErr) {
+		t.Error("not *LimitError")
+	}
+}
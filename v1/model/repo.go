@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+var (
+	ErrInvalidPath = errors.New("invalid path")
+	ErrOutsideRepo = errors.New("path outside of repository")
+)
+
+// RepoPath resolves name relative to the directory dir of the module repository. Names must use forward slashes
+// and must not be absolute or leave the repository.
+func RepoPath(dir, name string) (string, error) {
+	if name == "" || path.IsAbs(name) || strings.Contains(name, "\\") {
+		return "", ErrInvalidPath
+	}
+	p := path.Join(dir, name)
+	if !fs.ValidPath(p) {
+		return "", ErrOutsideRepo
+	}
+	return p, nil
+}
+
+// ReadFile reads the named file from the module repository. Files larger than maxSize bytes are not read
+// completely and reported as *LimitError, a maxSize of zero disables the limit.
+func ReadFile(fsys fs.FS, name string, maxSize int64) ([]byte, error) {
+	if maxSize <= 0 {
+		return fs.ReadFile(fsys, name)
+	}
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	b, err := io.ReadAll(io.LimitReader(f, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > maxSize {
+		return nil, &LimitError{Limit: LimitSize, Max: maxSize}
+	}
+	return b, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+)
+
+func TestReadFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.yml": {Data: []byte("a: 1\n")},
+	}
+	if b, err := ReadFile(fsys, "a.yml", 0); err != nil {
+		t.Error(err)
+	} else if string(b) != "a: 1\n" {
+		t.Errorf("%s != a: 1", b)
+	}
+	if _, err := ReadFile(fsys, "a.yml", 5); err != nil {
+		t.Error(err)
+	}
+	var lErr *LimitError
+	if _, err := ReadFile(fsys, "a.yml", 4); !errors.As(err, &lErr) {
+		t.Error("not *LimitError")
+	} else if lErr.Limit != LimitSize || lErr.Max != 4 {
+		t.Errorf("%s %d", lErr.Limit, lErr.Max)
+	}
+	if _, err := ReadFile(fsys, "b.yml", 4); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestRepoPath(t *testing.T) {
+	valid := map[[2]string]string{
+		{"", "a.yml"}:           "a.yml",
+		{"", "./a/../b.yml"}:    "b.yml",
+		{"configs", "a.yml"}:    "configs/a.yml",
+		{"configs", "../a.yml"}: "a.yml",
+	}
+	for in, want := range valid {
+		if p, err := RepoPath(in[0], in[1]); err != nil {
+			t.Error(err)
+		} else if p != want {
+			t.Errorf("%s != %s", p, want)
+		}
+	}
+	invalid := map[[2]string]error{
+		{"", ""}:               ErrInvalidPath,
+		{"", "/a.yml"}:         ErrInvalidPath,
+		{"", "configs\\a.yml"}: ErrInvalidPath,
+		{"", "../a.yml"}:       ErrOutsideRepo,
+		{"configs", "../../a"}: ErrOutsideRepo,
+	}
+	for in, want := range invalid {
+		if _, err := RepoPath(in[0], in[1]); !errors.Is(err, want) {
+			t.Errorf("%v: %v != %v", in, err, want)
+		}
+	}
+}
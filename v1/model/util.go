@@ -17,8 +17,11 @@
 package model
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -32,6 +35,21 @@ const Version = "v1"
 func (p *Port) UnmarshalYAML(yn *yaml.Node) error {
 	var it any
 	_ = yn.Decode(&it)
+	if err := p.set(it); err != nil {
+		return newNodeError(yn, err)
+	}
+	return nil
+}
+
+func (p *Port) UnmarshalJSON(b []byte) error {
+	it, err := decodeJSONValue(b)
+	if err != nil {
+		return err
+	}
+	return p.set(it)
+}
+
+func (p *Port) set(it any) error {
 	switch v := it.(type) {
 	case int:
 		if v < 0 {
@@ -51,43 +69,85 @@ func (p *Port) UnmarshalYAML(yn *yaml.Node) error {
 		}
 		*p = Port(v)
 	default:
-		return fmt.Errorf("invlid port: %v", v)
+		return fmt.Errorf("invalid port: %v", v)
 	}
 	return nil
 }
 
+func (p Port) MarshalYAML() (any, error) {
+	if n, err := strconv.ParseInt(string(p), 10, 64); err == nil {
+		return n, nil
+	}
+	return string(p), nil
+}
+
 func (p Port) Parse() ([]int, error) {
-	var r []int
+	first, last, err := p.bounds()
+	if err != nil {
+		return nil, err
+	}
+	r := make([]int, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		r = append(r, i)
+	}
+	return r, nil
+}
+
+// Count returns the number of ports without expanding ranges.
+func (p Port) Count() (int, error) {
+	first, last, err := p.bounds()
+	if err != nil {
+		return 0, err
+	}
+	return last - first + 1, nil
+}
+
+const maxPort = 65535
+
+func (p Port) bounds() (int, int, error) {
 	parts := strings.Split(string(p), "-")
 	pl := len(parts)
 	if pl < 1 || pl > 2 {
-		return nil, errors.New("invalid format")
-	} else {
-		var tmp []int
-		for _, part := range parts {
-			n, err := strconv.ParseInt(part, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			tmp = append(tmp, int(n))
+		return 0, 0, errors.New("invalid format")
+	}
+	var tmp []int
+	for _, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return 0, 0, err
 		}
-		if len(tmp) > 1 {
-			if tmp[0] >= tmp[1] {
-				return nil, errors.New("invalid range")
-			}
-			for i := tmp[0]; i <= tmp[1]; i++ {
-				r = append(r, i)
-			}
-		} else {
-			r = tmp
+		if n > maxPort {
+			return 0, 0, fmt.Errorf("port %d out of range", n)
 		}
+		tmp = append(tmp, int(n))
 	}
-	return r, nil
+	if len(tmp) > 1 {
+		if tmp[0] >= tmp[1] {
+			return 0, 0, errors.New("invalid range")
+		}
+		return tmp[0], tmp[1], nil
+	}
+	return tmp[0], tmp[0], nil
 }
 
 func (fb *ByteFmt) UnmarshalYAML(yn *yaml.Node) error {
 	var it any
 	_ = yn.Decode(&it)
+	if err := fb.set(it); err != nil {
+		return newNodeError(yn, err)
+	}
+	return nil
+}
+
+func (fb *ByteFmt) UnmarshalJSON(b []byte) error {
+	it, err := decodeJSONValue(b)
+	if err != nil {
+		return err
+	}
+	return fb.set(it)
+}
+
+func (fb *ByteFmt) set(it any) error {
 	switch v := it.(type) {
 	case int:
 		*fb = ByteFmt(v)
@@ -103,25 +163,87 @@ func (fb *ByteFmt) UnmarshalYAML(yn *yaml.Node) error {
 	return nil
 }
 
+var byteUnits = []struct {
+	unit string
+	size uint64
+}{
+	{"Tb", bytefmt.TERABYTE},
+	{"Gb", bytefmt.GIGABYTE},
+	{"Mb", bytefmt.MEGABYTE},
+	{"Kb", bytefmt.KILOBYTE},
+}
+
+// MarshalYAML uses the largest unit that divides the size without remainder, so that no precision is lost.
+func (fb ByteFmt) MarshalYAML() (any, error) {
+	if fb > 0 {
+		for _, u := range byteUnits {
+			if uint64(fb)%u.size == 0 {
+				return fmt.Sprintf("%d%s", uint64(fb)/u.size, u.unit), nil
+			}
+		}
+	}
+	return uint64(fb), nil
+}
+
 func (d *Duration) UnmarshalYAML(yn *yaml.Node) error {
 	var s string
 	if err := yn.Decode(&s); err != nil {
 		return err
 	}
 	if dur, err := time.ParseDuration(s); err != nil {
-		return err
+		return newNodeError(yn, err)
 	} else {
 		*d = Duration(dur)
 	}
 	return nil
 }
 
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid duration: %s", b)
+	}
+	dur, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(dur)
+	return nil
+}
+
+func (d Duration) MarshalYAML() (any, error) {
+	return time.Duration(d).String(), nil
+}
+
 func (m *FileMode) UnmarshalYAML(yn *yaml.Node) error {
 	var s string
 	if err := yn.Decode(&s); err != nil {
 		return err
 	}
 	i, err := strconv.ParseUint(s, 8, 32)
+	if err != nil {
+		return newNodeError(yn, err)
+	}
+	*m = FileMode(i)
+	return nil
+}
+
+// UnmarshalJSON accepts strings and, like the YAML decoder, numbers whose digits are interpreted as octal.
+func (m *FileMode) UnmarshalJSON(b []byte) error {
+	it, err := decodeJSONValue(b)
+	if err != nil {
+		return err
+	}
+	var s string
+	switch v := it.(type) {
+	case string:
+		s = v
+	case int:
+		s = strconv.FormatInt(int64(v), 10)
+	default:
+		return fmt.Errorf("invalid file mode: %v", v)
+	}
+	i, err := strconv.ParseUint(s, 8, 32)
 	if err != nil {
 		return err
 	}
@@ -129,6 +251,10 @@ func (m *FileMode) UnmarshalYAML(yn *yaml.Node) error {
 	return nil
 }
 
+func (m FileMode) MarshalYAML() (any, error) {
+	return fmt.Sprintf("%04o", uint32(m)), nil
+}
+
 func (r Resource) GetUserInput() *UserInput {
 	return r.UserInput
 }
@@ -140,6 +266,60 @@ func (c ConfigValue) GetUserInput() *UserInput {
 	return nil
 }
 
+func (c ConfigValue) MarshalYAML() (any, error) {
+	type configValue ConfigValue
+	cv := configValue(c)
+	cv.Value = marshalAny(c.Value)
+	if len(c.Options) > 0 {
+		cv.Options = make([]any, len(c.Options))
+		for i, o := range c.Options {
+			cv.Options[i] = marshalAny(o)
+		}
+	}
+	return cv, nil
+}
+
+func (c ConfigUserInput) MarshalYAML() (any, error) {
+	type configUserInput ConfigUserInput
+	ui := configUserInput(c)
+	if len(c.TypeOptions) > 0 {
+		ui.TypeOptions = make(map[string]any)
+		for key, val := range c.TypeOptions {
+			ui.TypeOptions[key] = marshalAny(val)
+		}
+	}
+	return ui, nil
+}
+
+// marshalAny keeps whole floats from being encoded as integers (e.g. 1 instead of 1.0).
+func marshalAny(val any) any {
+	switch v := val.(type) {
+	case float32:
+		return marshalFloat(float64(v), 32)
+	case float64:
+		return marshalFloat(v, 64)
+	case []any:
+		sl := make([]any, len(v))
+		for i, item := range v {
+			sl[i] = marshalAny(item)
+		}
+		return sl
+	default:
+		return val
+	}
+}
+
+func marshalFloat(f float64, bitSize int) any {
+	if math.IsInf(f, 0) || math.IsNaN(f) || f != math.Trunc(f) {
+		return f
+	}
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!float",
+		Value: strconv.FormatFloat(f, 'f', 1, bitSize),
+	}
+}
+
 func (f File) GetUserInput() UserInput {
 	return f.UserInput.UserInput
 }
@@ -160,3 +340,158 @@ func (t *StrOrSlice) UnmarshalYAML(yn *yaml.Node) error {
 	*t = sl
 	return nil
 }
+
+func (t StrOrSlice) MarshalYAML() (any, error) {
+	if len(t) == 1 {
+		return t[0], nil
+	}
+	return []string(t), nil
+}
+
+func (t *StrOrSlice) UnmarshalJSON(b []byte) error {
+	var sl []string
+	if err := json.Unmarshal(b, &sl); err != nil {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		sl = append(sl, s)
+	}
+	*t = sl
+	return nil
+}
+
+// UnmarshalJSON decodes whole numbers as int to match the YAML decoder.
+func (c *ConfigValue) UnmarshalJSON(b []byte) error {
+	type configValue ConfigValue
+	var cv configValue
+	if err := decodeJSONNumbers(b, &cv); err != nil {
+		return err
+	}
+	cv.Value = normalizeJSONValue(cv.Value)
+	for i, o := range cv.Options {
+		cv.Options[i] = normalizeJSONValue(o)
+	}
+	*c = ConfigValue(cv)
+	return nil
+}
+
+// UnmarshalJSON decodes whole numbers as int to match the YAML decoder.
+func (c *ConfigUserInput) UnmarshalJSON(b []byte) error {
+	type configUserInput ConfigUserInput
+	var ui configUserInput
+	if err := decodeJSONNumbers(b, &ui); err != nil {
+		return err
+	}
+	for key, val := range ui.TypeOptions {
+		ui.TypeOptions[key] = normalizeJSONValue(val)
+	}
+	*c = ConfigUserInput(ui)
+	return nil
+}
+
+func decodeJSONNumbers(b []byte, v any) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+// decodeJSONValue decodes a single JSON value, numbers are returned as int or float64.
+func decodeJSONValue(b []byte) (any, error) {
+	var v any
+	if err := decodeJSONNumbers(b, &v); err != nil {
+		return nil, err
+	}
+	return normalizeJSONValue(v), nil
+}
+
+func normalizeJSONValue(val any) any {
+	switch v := val.(type) {
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 0); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeJSONValue(item)
+		}
+		return v
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeJSONValue(item)
+		}
+		return v
+	default:
+		return val
+	}
+}
+
+// DecodeJSON decodes the document or node yn into v with encoding/json, so that the UnmarshalJSON methods of v
+// are used. Numbers and booleans keep their representation if it is valid JSON, timestamps are decoded as strings.
+func DecodeJSON(yn *yaml.Node, v any) error {
+	var buf bytes.Buffer
+	if err := encodeJSONNode(&buf, yn); err != nil {
+		return err
+	}
+	return json.Unmarshal(buf.Bytes(), v)
+}
+
+func encodeJSONNode(buf *bytes.Buffer, yn *yaml.Node) error {
+	switch yn.Kind {
+	case yaml.DocumentNode:
+		if len(yn.Content) == 0 {
+			buf.WriteString("null")
+			return nil
+		}
+		return encodeJSONNode(buf, yn.Content[0])
+	case yaml.AliasNode:
+		return encodeJSONNode(buf, yn.Alias)
+	case yaml.MappingNode:
+		buf.WriteByte('{')
+		for i := 0; i+1 < len(yn.Content); i += 2 {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			b, _ := json.Marshal(yn.Content[i].Value)
+			buf.Write(b)
+			buf.WriteByte(':')
+			if err := encodeJSONNode(buf, yn.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i, item := range yn.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeJSONNode(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case yaml.ScalarNode:
+		var val any = yn.Value
+		switch yn.ShortTag() {
+		case "!!null":
+			val = nil
+		case "!!bool", "!!int", "!!float":
+			if json.Valid([]byte(yn.Value)) {
+				buf.WriteString(yn.Value)
+				return nil
+			}
+			if err := yn.Decode(&val); err != nil {
+				return newNodeError(yn, err)
+			}
+		}
+		b, err := json.Marshal(val)
+		if err != nil {
+			return newNodeError(yn, err)
+		}
+		buf.Write(b)
+	}
+	return nil
+}
@@ -17,6 +17,7 @@
 package model
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
@@ -165,6 +166,28 @@ func TestPort_Parse(t *testing.T) {
 	if _, err := Port("").Parse(); err == nil {
 		t.Error("err == nil")
 	}
+	// ---------------------------
+	if _, err := Port("1-65536").Parse(); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestPort_Count(t *testing.T) {
+	if n, err := Port("80").Count(); err != nil {
+		t.Error("err != nil")
+	} else if n != 1 {
+		t.Errorf("%d != 1", n)
+	}
+	// ---------------------------
+	if n, err := Port("1-65535").Count(); err != nil {
+		t.Error("err != nil")
+	} else if n != 65535 {
+		t.Errorf("%d != 65535", n)
+	}
+	// ---------------------------
+	if _, err := Port("81-80").Count(); err == nil {
+		t.Error("err == nil")
+	}
 }
 
 func TestConfigValue_GetUserInput(t *testing.T) {
@@ -203,3 +226,276 @@ func TestStrOrSlice_UnmarshalYAML(t *testing.T) {
 		}
 	})
 }
+
+func TestDuration_MarshalYAML(t *testing.T) {
+	a := Duration(90 * time.Second)
+	if b, err := yaml.Marshal(a); err != nil {
+		t.Error("err != nil")
+	} else if string(b) != "1m30s\n" {
+		t.Errorf("%s != 1m30s", b)
+	}
+}
+
+func TestFileMode_MarshalYAML(t *testing.T) {
+	a := FileMode(504)
+	var b FileMode
+	if c, err := yaml.Marshal(a); err != nil {
+		t.Error("err != nil")
+	} else if err = yaml.Unmarshal(c, &b); err != nil {
+		t.Error("err != nil")
+	} else if a != b {
+		t.Errorf("%d != %d", a, b)
+	}
+}
+
+func TestPort_MarshalYAML(t *testing.T) {
+	t.Run("single", func(t *testing.T) {
+		a := Port("80")
+		var b Port
+		if c, err := yaml.Marshal(a); err != nil {
+			t.Error("err != nil")
+		} else if string(c) != "80\n" {
+			t.Errorf("%s != 80", c)
+		} else if err = yaml.Unmarshal(c, &b); err != nil {
+			t.Error("err != nil")
+		} else if a != b {
+			t.Errorf("%s != %s", a, b)
+		}
+	})
+	t.Run("range", func(t *testing.T) {
+		a := Port("80-81")
+		var b Port
+		if c, err := yaml.Marshal(a); err != nil {
+			t.Error("err != nil")
+		} else if err = yaml.Unmarshal(c, &b); err != nil {
+			t.Error("err != nil")
+		} else if a != b {
+			t.Errorf("%s != %s", a, b)
+		}
+	})
+}
+
+func TestByteFmt_MarshalYAML(t *testing.T) {
+	tests := map[ByteFmt]string{
+		0:                      "0\n",
+		1000:                   "1000\n",
+		2048:                   "2Kb\n",
+		67108864:               "64Mb\n",
+		1536 * 1024 * 1024:     "1536Mb\n",
+		2 * 1024 * 1024 * 1024: "2Gb\n",
+	}
+	for a, s := range tests {
+		var b ByteFmt
+		if c, err := yaml.Marshal(a); err != nil {
+			t.Error("err != nil")
+		} else if string(c) != s {
+			t.Errorf("%s != %s", c, s)
+		} else if err = yaml.Unmarshal(c, &b); err != nil {
+			t.Error("err != nil")
+		} else if a != b {
+			t.Errorf("%d != %d", a, b)
+		}
+	}
+}
+
+func TestStrOrSlice_MarshalYAML(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		a := StrOrSlice{"test"}
+		var b StrOrSlice
+		if c, err := yaml.Marshal(a); err != nil {
+			t.Error("err != nil")
+		} else if string(c) != "test\n" {
+			t.Errorf("%s != test", c)
+		} else if err = yaml.Unmarshal(c, &b); err != nil {
+			t.Error("err != nil")
+		} else if !reflect.DeepEqual(a, b) {
+			t.Errorf("%v != %v", a, b)
+		}
+	})
+	t.Run("slice", func(t *testing.T) {
+		a := StrOrSlice{"test", "test"}
+		var b StrOrSlice
+		if c, err := yaml.Marshal(a); err != nil {
+			t.Error("err != nil")
+		} else if err = yaml.Unmarshal(c, &b); err != nil {
+			t.Error("err != nil")
+		} else if !reflect.DeepEqual(a, b) {
+			t.Errorf("%v != %v", a, b)
+		}
+	})
+}
+
+func TestConfigValue_MarshalYAML(t *testing.T) {
+	a := ConfigValue{
+		Value:   1.0,
+		Options: []any{1.0, 1.5},
+		UserInput: &ConfigUserInput{
+			TypeOptions: map[string]any{"min": 0.0},
+		},
+	}
+	var b ConfigValue
+	if c, err := yaml.Marshal(a); err != nil {
+		t.Error("err != nil")
+	} else if err = yaml.Unmarshal(c, &b); err != nil {
+		t.Error("err != nil")
+	} else if !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+}
+
+func TestPort_UnmarshalJSON(t *testing.T) {
+	a := Port("80")
+	var b Port
+	if err := json.Unmarshal([]byte("80"), &b); err != nil {
+		t.Error("err != nil")
+	} else if a != b {
+		t.Errorf("%s != %s", a, b)
+	}
+	// ---------------------------
+	a = "80-81"
+	if err := json.Unmarshal([]byte(`"80-81"`), &b); err != nil {
+		t.Error("err != nil")
+	} else if a != b {
+		t.Errorf("%s != %s", a, b)
+	}
+	// ---------------------------
+	if err := json.Unmarshal([]byte("-1"), &b); err == nil {
+		t.Error("err == nil")
+	}
+	// ---------------------------
+	if err := json.Unmarshal([]byte("1.1"), &b); err == nil {
+		t.Error("err == nil")
+	}
+	// ---------------------------
+	if err := json.Unmarshal([]byte(`"test"`), &b); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestByteFmt_UnmarshalJSON(t *testing.T) {
+	a := ByteFmt(67108864)
+	var b ByteFmt
+	if err := json.Unmarshal([]byte(`"64Mb"`), &b); err != nil {
+		t.Error("err != nil")
+	} else if a != b {
+		t.Errorf("%d != %d", a, b)
+	}
+	// ---------------------------
+	if err := json.Unmarshal([]byte("67108864"), &b); err != nil {
+		t.Error("err != nil")
+	} else if a != b {
+		t.Errorf("%d != %d", a, b)
+	}
+	// ---------------------------
+	if err := json.Unmarshal([]byte("1.1"), &b); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	a := Duration(time.Second)
+	var b Duration
+	if err := json.Unmarshal([]byte(`"1s"`), &b); err != nil {
+		t.Error("err != nil")
+	} else if a != b {
+		t.Errorf("%d != %d", a, b)
+	}
+	// ---------------------------
+	if err := json.Unmarshal([]byte("1000000000"), &b); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestFileMode_UnmarshalJSON(t *testing.T) {
+	a := FileMode(0770)
+	var b FileMode
+	if err := json.Unmarshal([]byte(`"0770"`), &b); err != nil {
+		t.Error("err != nil")
+	} else if a != b {
+		t.Errorf("%o != %o", a, b)
+	}
+	// ---------------------------
+	if err := json.Unmarshal([]byte("770"), &b); err != nil {
+		t.Error("err != nil")
+	} else if a != b {
+		t.Errorf("%o != %o", a, b)
+	}
+	// ---------------------------
+	if err := json.Unmarshal([]byte("780"), &b); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestStrOrSlice_UnmarshalJSON(t *testing.T) {
+	a := StrOrSlice{"test"}
+	var b StrOrSlice
+	if err := json.Unmarshal([]byte(`"test"`), &b); err != nil {
+		t.Error("err != nil")
+	} else if !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	// ---------------------------
+	a = StrOrSlice{"test", "test"}
+	if err := json.Unmarshal([]byte(`["test", "test"]`), &b); err != nil {
+		t.Error("err != nil")
+	} else if !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+}
+
+func TestConfigValue_UnmarshalJSON(t *testing.T) {
+	var a ConfigValue
+	if err := yaml.Unmarshal([]byte("{value: 1, options: [1, 1.5], userInput: {name: a, type: number, typeOptions: {min: 0}}}"), &a); err != nil {
+		t.Fatal(err)
+	}
+	var b ConfigValue
+	if err := json.Unmarshal([]byte(`{"value": 1, "options": [1, 1.5], "userInput": {"name": "a", "type": "number", "typeOptions": {"min": 0}}}`), &b); err != nil {
+		t.Error("err != nil")
+	} else if !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	doc := `{
+		"modfileVersion": "v1",
+		"services": {
+			"api": {
+				"image": "ghcr.io/user/api:v1.0.0",
+				"runConfig": {"stopTimeout": "10s", "command": ["run", "fast"]},
+				"tmpfs": [{"mountPoint": "/tmp", "size": "64Mb", "mode": 700}],
+				"ports": [{"port": "90-91", "hostPort": 9090}]
+			}
+		},
+		"configs": {
+			"ratio": {"value": 1.0, "dataType": "float", "options": [0.5, 1e3], "targets": null}
+		}
+	}`
+	var a ModFile
+	if err := json.Unmarshal([]byte(doc), &a); err != nil {
+		t.Fatal(err)
+	}
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	var b ModFile
+	if err := DecodeJSON(&yn, &b); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(a, b) {
+		t.Errorf("%+v != %+v", a, b)
+	}
+	// ---------------------------
+	if err := yaml.Unmarshal([]byte("a: &x {b: 0o700, c: 2024-01-01, d: ~}\ne: *x"), &yn); err != nil {
+		t.Fatal(err)
+	}
+	var c map[string]any
+	if err := DecodeJSON(&yn, &c); err != nil {
+		t.Fatal(err)
+	}
+	if d, err := json.Marshal(c); err != nil {
+		t.Error(err)
+	} else if s := `{"a":{"b":448,"c":"2024-01-01","d":null},"e":{"b":448,"c":"2024-01-01","d":null}}`; string(d) != s {
+		t.Errorf("%s != %s", d, s)
+	}
+}
@@ -0,0 +1,257 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+
+	v1_generator "github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/configs"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/generic"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/inputs"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/mounts"
+	v1_services "github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/services"
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v2/generator/services"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v2/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+	"gopkg.in/yaml.v3"
+)
+
+type Options = v1_generator.Options
+
+// GetModule decodes and generates a module. Returned errors are located in yn, see v1 model.Locate.
+func GetModule(yn *yaml.Node) (module_lib.Module, error) {
+	return GetModuleWithOptions(yn, Options{})
+}
+
+// GetModuleWithOptions is like GetModule but applies the provided options.
+func GetModuleWithOptions(yn *yaml.Node, opt Options) (module_lib.Module, error) {
+	return pipeline.Run(yn, opt)
+}
+
+// Generate decodes and generates a module from a modfile document prepared by v1 generator.Prepare, see
+// v1 generator.Pipeline.Generate.
+func Generate(yn *yaml.Node, inc v1_model.Includes, opt Options) (module_lib.Module, error) {
+	return pipeline.Generate(yn, inc, opt)
+}
+
+// GenerateJSON is like Generate but decodes the document with encoding/json, see v1_model.DecodeJSON. Used for JSON
+// modfiles, so that they are decoded with the UnmarshalJSON methods of the model.
+func GenerateJSON(yn *yaml.Node, inc v1_model.Includes, opt Options) (module_lib.Module, error) {
+	return jsonPipeline.Generate(yn, inc, opt)
+}
+
+var pipeline = v1_generator.Pipeline{
+	Model: model.ModFile{},
+	Decode: func(yn *yaml.Node) (v1_generator.Modfile, error) {
+		var mf model.ModFile
+		err := yn.Decode(&mf)
+		return modfile{mf: mf}, err
+	},
+}
+
+var jsonPipeline = v1_generator.Pipeline{
+	Model: model.ModFile{},
+	Decode: func(yn *yaml.Node) (v1_generator.Modfile, error) {
+		var mf model.ModFile
+		err := v1_model.DecodeJSON(yn, &mf)
+		return modfile{mf: mf}, err
+	},
+}
+
+type modfile struct {
+	mf model.ModFile
+}
+
+func (m modfile) Generate() (module_lib.Module, error) {
+	return generateModule(m.mf)
+}
+
+func (m modfile) Files() map[string]v1_model.File {
+	return genV1Files(m.mf.Files)
+}
+
+// generateModule does not stop at the first error, all errors found in the modfile are returned as a single joined error.
+// The module is returned even if errors occur.
+func generateModule(mf model.ModFile) (module_lib.Module, error) {
+	var errs []error
+	mCs, err := configs.GenConfigs(genV1Configs(mf.Configs))
+	if err != nil {
+		errs = append(errs, err)
+	}
+	defs := services.Defs{
+		Services:      keys(mf.Services),
+		Volumes:       generic.GenStringSet(mf.Volumes),
+		Dependencies:  dependencyIDs(mf.Dependencies),
+		HostResources: keys(mf.HostResources),
+		Secrets:       keys(mf.Secrets),
+		Configs:       keys(mf.Configs),
+		Files:         keys(mf.Files),
+		FileGroups:    keys(mf.FileGroups),
+	}
+	mSs, err := services.GenServices(mf.Services, defs)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	mAs, err := services.GenAuxServices(mf.AuxServices, defs)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if err = v1_services.CheckMountPoints(mSs, mAs); err != nil {
+		errs = append(errs, err)
+	}
+	architectures, err := v1_generator.GenArchitectures(mf.Architectures)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	auxImgSrc, err := v1_generator.GenAuxImageSources(mf.AuxImageSources)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if err = services.CheckAuxImages(mf.AuxServices, auxImgSrc); err != nil {
+		errs = append(errs, err)
+	}
+	return module_lib.Module{
+		ID:            mf.ID,
+		Name:          mf.Name,
+		Description:   mf.Description,
+		Tags:          generic.GenStringSet(mf.Tags),
+		License:       mf.License,
+		Author:        mf.Author,
+		Version:       mf.Version,
+		Architectures: architectures,
+		Services:      mSs,
+		Volumes:       generic.GenStringSet(mf.Volumes),
+		Dependencies:  genDependencies(mf.Dependencies),
+		HostResources: mounts.GenHostResources(genV1HostResources(mf.HostResources)),
+		Secrets:       mounts.GenSecrets(genV1Secrets(mf.Secrets)),
+		Files:         mounts.GenFiles(genV1Files(mf.Files)),
+		FileGroups:    mounts.GenFileGroups(genV1FileGroups(mf.FileGroups)),
+		Configs:       mCs,
+		Inputs: module_lib.Inputs{
+			Resources:  inputs.GenOptInputs(mf.HostResources),
+			Secrets:    inputs.GenOptInputs(mf.Secrets),
+			Configs:    inputs.GenOptInputs(mf.Configs),
+			Files:      inputs.GenReqInputs(mf.Files),
+			FileGroups: inputs.GenReqInputs(mf.FileGroups),
+			Groups:     inputs.GenInputGroups(mf.InputGroups),
+		},
+		AuxServices: mAs,
+		AuxImgSrc:   auxImgSrc,
+	}, errors.Join(errs...)
+}
+
+func genDependencies(mfMDs map[string]model.ModuleDependency) map[string]string {
+	if len(mfMDs) == 0 {
+		return nil
+	}
+	mDs := make(map[string]string)
+	for id, mfMD := range mfMDs {
+		mDs[id] = mfMD.Version
+	}
+	return mDs
+}
+
+// The following functions convert definitions to their v1 counterparts (without targets) to reuse the v1 generators.
+
+func genV1Configs(mfCVs map[string]model.ConfigValue) map[string]v1_model.ConfigValue {
+	if len(mfCVs) == 0 {
+		return nil
+	}
+	v1CVs := make(map[string]v1_model.ConfigValue)
+	for ref, mfCV := range mfCVs {
+		v1CVs[ref] = v1_model.ConfigValue{
+			Value:      mfCV.Value,
+			Options:    mfCV.Options,
+			OptionsExt: mfCV.OptionsExt,
+			DataType:   mfCV.DataType,
+			IsList:     mfCV.IsList,
+			Delimiter:  mfCV.Delimiter,
+			UserInput:  mfCV.UserInput,
+			Optional:   mfCV.Optional,
+		}
+	}
+	return v1CVs
+}
+
+func genV1HostResources(mfRs map[string]model.Resource) map[string]v1_model.HostResource {
+	if len(mfRs) == 0 {
+		return nil
+	}
+	v1Rs := make(map[string]v1_model.HostResource)
+	for ref, mfR := range mfRs {
+		v1Rs[ref] = v1_model.HostResource{Resource: mfR}
+	}
+	return v1Rs
+}
+
+func genV1Secrets(mfSs map[string]model.Secret) map[string]v1_model.Secret {
+	if len(mfSs) == 0 {
+		return nil
+	}
+	v1Ss := make(map[string]v1_model.Secret)
+	for ref, mfS := range mfSs {
+		v1Ss[ref] = v1_model.Secret{Resource: mfS.Resource, Type: mfS.Type}
+	}
+	return v1Ss
+}
+
+func genV1Files(mfFs map[string]model.File) map[string]v1_model.File {
+	if len(mfFs) == 0 {
+		return nil
+	}
+	v1Fs := make(map[string]v1_model.File)
+	for ref, mfF := range mfFs {
+		v1Fs[ref] = v1_model.File{Source: mfF.Source, Schema: mfF.Schema, UserInput: mfF.UserInput, Optional: mfF.Optional}
+	}
+	return v1Fs
+}
+
+func genV1FileGroups(mfFGs map[string]model.FileGroup) map[string]v1_model.FileGroup {
+	if len(mfFGs) == 0 {
+		return nil
+	}
+	v1FGs := make(map[string]v1_model.FileGroup)
+	for ref, mfFG := range mfFGs {
+		v1FGs[ref] = v1_model.FileGroup{UserInput: mfFG.UserInput}
+	}
+	return v1FGs
+}
+
+// dependencyIDs returns the module IDs of the dependencies in their canonical form, see services.Defs.
+func dependencyIDs(mfMDs map[string]model.ModuleDependency) map[string]struct{} {
+	if len(mfMDs) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{})
+	for id := range mfMDs {
+		set[services.CanonicalID(id)] = struct{}{}
+	}
+	return set
+}
+
+func keys[V any](m map[string]V) map[string]struct{} {
+	if len(m) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{})
+	for key := range m {
+		set[key] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v2/generator/services"
+	"gopkg.in/yaml.v3"
+)
+
+const testModFile = `modfileVersion: v2
+id: github.com/user/test
+name: Test
+version: v1.0.0
+services:
+  a:
+    name: A
+    image: a:v1.0.0
+    volumes:
+      /data: vol
+    configs:
+      LEVEL: level
+    srvReferences:
+      B_HOST:
+        ref: b
+volumes:
+  - vol
+configs:
+  level:
+    value: info
+`
+
+func TestGetModule(t *testing.T) {
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(testModFile), &yn); err != nil {
+		t.Fatal(err)
+	}
+	_, err := GetModule(&yn)
+	var e *v1_model.Error
+	var urErr *services.UndefinedRefError
+	if !errors.As(err, &e) || !errors.As(err, &urErr) {
+		t.Fatal("not *Error / *UndefinedRefError")
+	}
+	if e.Path.String() != "services.a.srvReferences.B_HOST" || e.Line != 15 {
+		t.Errorf("%s %d", e.Path, e.Line)
+	}
+	if urErr.Service != "a" || urErr.Section != "srvReferences" || urErr.Key != "B_HOST" || urErr.Ref != "b" {
+		t.Errorf("%+v", urErr)
+	}
+	// ---------------------------
+	doc := strings.Replace(testModFile, "\nvolumes:\n", "\n  b:\n    name: B\n    image: b:v1.0.0\nvolumes:\n", 1)
+	if err = yaml.Unmarshal([]byte(doc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	mod, err := GetModuleWithOptions(&yn, Options{Strict: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mS := mod.Services["a"]
+	if mS.Volumes["/data"] != "vol" || mS.Configs["LEVEL"] != "level" || mS.SrvReferences["B_HOST"].Ref != "b" {
+		t.Errorf("%+v", mS)
+	}
+	if _, ok := mod.Volumes["vol"]; !ok {
+		t.Error("volume missing")
+	}
+}
+
+func TestGetModuleAuxImage(t *testing.T) {
+	doc := testModFile + "auxServices:\n  job:\n    name: Job\n    image: ghcr.io/user/job:v1.0.0\nauxImageSources:\n  - ghcr.io/user/*\n"
+	doc = strings.Replace(doc, "    srvReferences:\n      B_HOST:\n        ref: b\n", "", 1)
+	var yn yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &yn); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GetModuleWithOptions(&yn, Options{Strict: true}); err != nil {
+		t.Fatal(err)
+	}
+	// ---------------------------
+	if err := yaml.Unmarshal([]byte(strings.Replace(doc, "ghcr.io/user/job", "docker.io/user/job", 1)), &yn); err != nil {
+		t.Fatal(err)
+	}
+	_, err := GetModule(&yn)
+	var e *v1_model.Error
+	var inaErr *services.ImageNotAllowedError
+	if !errors.As(err, &e) || !errors.As(err, &inaErr) {
+		t.Fatal("not *Error / *ImageNotAllowedError")
+	}
+	if e.Path.String() != "auxServices.job.image" || inaErr.Service != "job" {
+		t.Errorf("%s %+v", e.Path, inaErr)
+	}
+}
+
+func TestGenV1Empty(t *testing.T) {
+	if genV1HostResources(nil) != nil || genV1Secrets(nil) != nil || genV1Files(nil) != nil || genV1FileGroups(nil) != nil {
+		t.Error("expected nil")
+	}
+	if dependencyIDs(nil) != nil || keys(map[string]struct{}{}) != nil {
+		t.Error("expected nil")
+	}
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package services
+
+import "fmt"
+
+// UndefinedRefError indicates that a service refers to an identifier not defined in the respective top-level section.
+type UndefinedRefError struct {
+	Service string // referencing service
+	Aux     bool   // true if Service is an aux service
+	Section string // section of the service containing the reference, e.g. "volumes"
+	Key     string // mount point, base path or reference variable
+	Ref     string // referenced identifier
+}
+
+func (e *UndefinedRefError) Error() string {
+	kind := "service"
+	if e.Aux {
+		kind = "aux service"
+	}
+	return fmt.Sprintf("%s '%s' invalid %s '%s': '%s' not defined", kind, e.Service, e.Section, e.Key, e.Ref)
+}
+
+// ImageNotAllowedError indicates that the image of an aux service is not matched by any aux image source.
+type ImageNotAllowedError struct {
+	Service string
+	Image   string
+}
+
+func (e *ImageNotAllowedError) Error() string {
+	return fmt.Sprintf("aux service '%s' image '%s' not allowed by aux image sources", e.Service, e.Image)
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package services
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/imageref"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/moduleid"
+	v1_services "github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/services"
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v2/model"
+	module_lib "github.com/SENERGY-Platform/mgw-module-lib/model"
+)
+
+// Defs holds the identifiers of the top-level definitions services can refer to. Module IDs of dependencies
+// are expected in their canonical form, see CanonicalID.
+type Defs struct {
+	Services      map[string]struct{}
+	Volumes       map[string]struct{}
+	Dependencies  map[string]struct{}
+	HostResources map[string]struct{}
+	Secrets       map[string]struct{}
+	Configs       map[string]struct{}
+	Files         map[string]struct{}
+	FileGroups    map[string]struct{}
+}
+
+// GenServices returns all services even if errors occur. References to top-level definitions are checked against defs.
+func GenServices(mfSs map[string]model.Service, defs Defs) (map[string]module_lib.Service, error) {
+	if len(mfSs) == 0 {
+		return nil, nil
+	}
+	v1Ss := make(map[string]v1_model.Service)
+	for ref, mfS := range mfSs {
+		v1Ss[ref] = v1_model.Service{
+			Name:              mfS.Name,
+			Image:             mfS.Image,
+			RunConfig:         mfS.RunConfig,
+			Include:           mfS.Include,
+			Tmpfs:             mfS.Tmpfs,
+			HttpEndpoints:     mfS.HttpEndpoints,
+			Ports:             mfS.Ports,
+			DeviceCGroupRules: mfS.DeviceCGroupRules,
+		}
+	}
+	mSs, err := v1_services.GenServices(v1Ss)
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, ref := range maputil.SortedKeys(mfSs) {
+		mfS := mfSs[ref]
+		c := checker{path: v1_model.Path{"services", ref}, service: ref}
+		checkRefs(&c, "volumes", mfS.Volumes, defs.Volumes, identity)
+		checkRefs(&c, "hostResources", mfS.HostResources, defs.HostResources, func(v model.HostResourceMount) string { return v.Ref })
+		checkRefs(&c, "secretMounts", mfS.SecretMounts, defs.Secrets, func(v model.SecretTarget) string { return v.Ref })
+		checkRefs(&c, "secretVars", mfS.SecretVars, defs.Secrets, func(v model.SecretTarget) string { return v.Ref })
+		checkRefs(&c, "configs", mfS.Configs, defs.Configs, identity)
+		checkRefs(&c, "files", mfS.Files, defs.Files, identity)
+		checkRefs(&c, "fileGroups", mfS.FileGroups, defs.FileGroups, identity)
+		checkRefs(&c, "srvReferences", mfS.SrvReferences, defs.Services, func(v model.SrvReference) string { return v.Ref })
+		checkRefs(&c, "extDependencies", mfS.ExtDependencies, defs.Dependencies, func(v model.ExtDependency) string { return CanonicalID(v.ID) })
+		errs = append(errs, c.errs...)
+		mS := mSs[ref]
+		mS.Volumes = genMap(mfS.Volumes, identity)
+		mS.HostResources = genMap(mfS.HostResources, func(v model.HostResourceMount) module_lib.HostResTarget {
+			return module_lib.HostResTarget{Ref: v.Ref, ReadOnly: v.ReadOnly}
+		})
+		mS.SecretMounts = genMap(mfS.SecretMounts, genSecretTarget)
+		mS.SecretVars = genMap(mfS.SecretVars, genSecretTarget)
+		mS.Configs = genMap(mfS.Configs, identity)
+		mS.Files = genMap(mfS.Files, identity)
+		mS.FileGroups = genMap(mfS.FileGroups, identity)
+		mS.SrvReferences = genMap(mfS.SrvReferences, genSrvRefTarget)
+		mS.ExtDependencies = genMap(mfS.ExtDependencies, genExtDependencyTarget)
+		mSs[ref] = mS
+	}
+	return mSs, errors.Join(errs...)
+}
+
+// GenAuxServices returns all aux services even if errors occur. References to top-level definitions are checked against defs.
+func GenAuxServices(mfSs map[string]model.AuxService, defs Defs) (map[string]module_lib.AuxService, error) {
+	if len(mfSs) == 0 {
+		return nil, nil
+	}
+	v1As := make(map[string]v1_model.AuxService)
+	for ref, mfS := range mfSs {
+		v1As[ref] = v1_model.AuxService{
+			Name:      mfS.Name,
+			RunConfig: mfS.RunConfig,
+			Include:   mfS.Include,
+			Tmpfs:     mfS.Tmpfs,
+		}
+	}
+	mAs, err := v1_services.GenAuxServices(v1As)
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, ref := range maputil.SortedKeys(mfSs) {
+		mfS := mfSs[ref]
+		c := checker{path: v1_model.Path{"auxServices", ref}, service: ref, aux: true}
+		checkRefs(&c, "volumes", mfS.Volumes, defs.Volumes, identity)
+		checkRefs(&c, "configs", mfS.Configs, defs.Configs, identity)
+		checkRefs(&c, "srvReferences", mfS.SrvReferences, defs.Services, func(v model.SrvReference) string { return v.Ref })
+		checkRefs(&c, "extDependencies", mfS.ExtDependencies, defs.Dependencies, func(v model.ExtDependency) string { return CanonicalID(v.ID) })
+		errs = append(errs, c.errs...)
+		mA := mAs[ref]
+		mA.Volumes = genMap(mfS.Volumes, identity)
+		mA.Configs = genMap(mfS.Configs, identity)
+		mA.SrvReferences = genMap(mfS.SrvReferences, genSrvRefTarget)
+		mA.ExtDependencies = genMap(mfS.ExtDependencies, genExtDependencyTarget)
+		mAs[ref] = mA
+	}
+	return mAs, errors.Join(errs...)
+}
+
+// CheckAuxImages checks that the images of aux services are valid and allowed by auxImgSrc, see imageref.AuxImageAllowed.
+func CheckAuxImages(mfSs map[string]model.AuxService, auxImgSrc map[string]struct{}) error {
+	var errs []error
+	for _, ref := range maputil.SortedKeys(mfSs) {
+		image := mfSs[ref].Image
+		if image == "" {
+			continue
+		}
+		path := v1_model.Path{"auxServices", ref, "image"}
+		if _, err := imageref.Check(image, nil); err != nil {
+			errs = append(errs, v1_model.WrapErrors(err, path, "aux service '%s' invalid image: %w", ref))
+		} else if !imageref.AuxImageAllowed(module_lib.Module{AuxImgSrc: auxImgSrc}, image) {
+			errs = append(errs, v1_model.NewError(path, &ImageNotAllowedError{Service: ref, Image: image}))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type checker struct {
+	path    v1_model.Path
+	service string
+	aux     bool
+	errs    []error
+}
+
+func checkRefs[V any](c *checker, section string, m map[string]V, ids map[string]struct{}, ref func(V) string) {
+	for _, key := range maputil.SortedKeys(m) {
+		r := ref(m[key])
+		if _, ok := ids[r]; ok {
+			continue
+		}
+		c.errs = append(c.errs, v1_model.NewError(append(slices.Clone(c.path), section, key), &UndefinedRefError{
+			Service: c.service,
+			Aux:     c.aux,
+			Section: section,
+			Key:     key,
+			Ref:     r,
+		}))
+	}
+}
+
+func genMap[V, M any](m map[string]V, gen func(V) M) map[string]M {
+	if len(m) == 0 {
+		return nil
+	}
+	mM := make(map[string]M)
+	for key, v := range m {
+		mM[key] = gen(v)
+	}
+	return mM
+}
+
+func genSecretTarget(v model.SecretTarget) module_lib.SecretTarget {
+	return module_lib.SecretTarget{Ref: v.Ref, Item: v.Item}
+}
+
+func genSrvRefTarget(v model.SrvReference) module_lib.SrvRefTarget {
+	return module_lib.SrvRefTarget{Ref: v.Ref, Template: v.Template}
+}
+
+func genExtDependencyTarget(v model.ExtDependency) module_lib.ExtDependencyTarget {
+	return module_lib.ExtDependencyTarget{ID: v.ID, Service: v.Service, Template: v.Template}
+}
+
+// CanonicalID returns the canonical form of a module ID or the ID as is if invalid, see moduleid.Canonical.
+func CanonicalID(id string) string {
+	if c, err := moduleid.Canonical(id); err == nil {
+		return c
+	}
+	return id
+}
+
+func identity(v string) string {
+	return v
+}
@@ -0,0 +1,333 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migration
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/services"
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v2/model"
+)
+
+// Migrate converts a v1 modfile into an equivalent v2 modfile by moving the targets of the top-level sections
+// to the respective services. All problems found during the conversion are returned as a single joined error.
+func Migrate(mf v1_model.ModFile) (model.ModFile, error) {
+	m := migrator{
+		services:    make(map[string]*model.Service),
+		auxServices: make(map[string]*model.AuxService),
+	}
+	for ref, mfS := range mf.Services {
+		m.services[ref] = &model.Service{
+			Name:              mfS.Name,
+			Image:             mfS.Image,
+			RunConfig:         mfS.RunConfig,
+			Include:           mfS.Include,
+			Tmpfs:             mfS.Tmpfs,
+			HttpEndpoints:     mfS.HttpEndpoints,
+			Ports:             mfS.Ports,
+			DeviceCGroupRules: mfS.DeviceCGroupRules,
+		}
+	}
+	for ref, mfA := range mf.AuxServices {
+		m.auxServices[ref] = &model.AuxService{
+			Name:      mfA.Name,
+			RunConfig: mfA.RunConfig,
+			Include:   mfA.Include,
+			Tmpfs:     mfA.Tmpfs,
+		}
+	}
+	v2MF := model.ModFile{
+		ModfileVersion:  model.Version,
+		ID:              mf.ID,
+		Name:            mf.Name,
+		Description:     mf.Description,
+		Tags:            mf.Tags,
+		License:         mf.License,
+		Author:          mf.Author,
+		Version:         mf.Version,
+		Architectures:   mf.Architectures,
+		AuxImageSources: mf.AuxImageSources,
+		InputGroups:     mf.InputGroups,
+	}
+	for _, ref := range maputil.SortedKeys(mf.ServiceReferences) {
+		for i, mfDT := range mf.ServiceReferences[ref] {
+			t := target{
+				section: services.SectionServiceReferences,
+				key:     ref,
+				path:    v1_model.Path{services.SectionServiceReferences, ref, i},
+				field:   "refVar",
+				name:    mfDT.RefVar,
+			}
+			val := model.SrvReference{Ref: ref, Template: mfDT.Template}
+			applyTarget(&m, m.services, false, t, mfDT.Services, func(s *model.Service) *services.DuplicateTargetError {
+				return set(&s.SrvReferences, mfDT.RefVar, val)
+			})
+			applyTarget(&m, m.auxServices, true, t, mfDT.AuxServices, func(a *model.AuxService) *services.DuplicateTargetError {
+				return set(&a.SrvReferences, mfDT.RefVar, val)
+			})
+		}
+	}
+	for _, ref := range maputil.SortedKeys(mf.Volumes) {
+		v2MF.Volumes = append(v2MF.Volumes, ref)
+		for i, mfVT := range mf.Volumes[ref] {
+			t := target{
+				section: services.SectionVolumes,
+				key:     ref,
+				path:    v1_model.Path{services.SectionVolumes, ref, i},
+				field:   "mountPoint",
+				name:    mfVT.MountPoint,
+			}
+			applyTarget(&m, m.services, false, t, mfVT.Services, func(s *model.Service) *services.DuplicateTargetError {
+				return set(&s.Volumes, mfVT.MountPoint, ref)
+			})
+			applyTarget(&m, m.auxServices, true, t, mfVT.AuxServices, func(a *model.AuxService) *services.DuplicateTargetError {
+				return set(&a.Volumes, mfVT.MountPoint, ref)
+			})
+		}
+	}
+	if len(mf.Dependencies) > 0 {
+		v2MF.Dependencies = make(map[string]model.ModuleDependency)
+	}
+	for _, id := range maputil.SortedKeys(mf.Dependencies) {
+		mfMD := mf.Dependencies[id]
+		v2MF.Dependencies[id] = model.ModuleDependency{Version: mfMD.Version}
+		for _, extRef := range maputil.SortedKeys(mfMD.RequiredServices) {
+			for i, mfDT := range mfMD.RequiredServices[extRef] {
+				t := target{
+					section: services.SectionDependencies,
+					key:     id,
+					path:    v1_model.Path{services.SectionDependencies, id, "requiredServices", extRef, i},
+					field:   "refVar",
+					name:    mfDT.RefVar,
+				}
+				val := model.ExtDependency{ID: id, Service: extRef, Template: mfDT.Template}
+				applyTarget(&m, m.services, false, t, mfDT.Services, func(s *model.Service) *services.DuplicateTargetError {
+					return set(&s.ExtDependencies, mfDT.RefVar, val)
+				})
+				applyTarget(&m, m.auxServices, true, t, mfDT.AuxServices, func(a *model.AuxService) *services.DuplicateTargetError {
+					return set(&a.ExtDependencies, mfDT.RefVar, val)
+				})
+			}
+		}
+	}
+	if len(mf.HostResources) > 0 {
+		v2MF.HostResources = make(map[string]model.Resource)
+	}
+	for _, ref := range maputil.SortedKeys(mf.HostResources) {
+		mfR := mf.HostResources[ref]
+		v2MF.HostResources[ref] = mfR.Resource
+		for i, mfRT := range mfR.Targets {
+			t := target{
+				section: services.SectionHostResources,
+				key:     ref,
+				path:    v1_model.Path{services.SectionHostResources, ref, "targets", i},
+				field:   "mountPoint",
+				name:    mfRT.MountPoint,
+			}
+			val := model.HostResourceMount{Ref: ref, ReadOnly: mfRT.ReadOnly}
+			applyTarget(&m, m.services, false, t, mfRT.Services, func(s *model.Service) *services.DuplicateTargetError {
+				return set(&s.HostResources, mfRT.MountPoint, val)
+			})
+		}
+	}
+	if len(mf.Secrets) > 0 {
+		v2MF.Secrets = make(map[string]model.Secret)
+	}
+	for _, ref := range maputil.SortedKeys(mf.Secrets) {
+		mfS := mf.Secrets[ref]
+		v2MF.Secrets[ref] = model.Secret{Resource: mfS.Resource, Type: mfS.Type}
+		for i, mfST := range mfS.Targets {
+			val := model.SecretTarget{Ref: ref, Item: mfST.Item}
+			t := target{
+				section: services.SectionSecrets,
+				key:     ref,
+				path:    v1_model.Path{services.SectionSecrets, ref, "targets", i},
+			}
+			if mfST.MountPoint != "" {
+				t.field, t.name = "mountPoint", mfST.MountPoint
+				applyTarget(&m, m.services, false, t, mfST.Services, func(s *model.Service) *services.DuplicateTargetError {
+					return set(&s.SecretMounts, mfST.MountPoint, val)
+				})
+			}
+			if mfST.RefVar != "" {
+				t.field, t.name = "refVar", mfST.RefVar
+				applyTarget(&m, m.services, false, t, mfST.Services, func(s *model.Service) *services.DuplicateTargetError {
+					return set(&s.SecretVars, mfST.RefVar, val)
+				})
+			}
+		}
+	}
+	if len(mf.Configs) > 0 {
+		v2MF.Configs = make(map[string]model.ConfigValue)
+	}
+	for _, ref := range maputil.SortedKeys(mf.Configs) {
+		mfCV := mf.Configs[ref]
+		v2MF.Configs[ref] = model.ConfigValue{
+			Value:      mfCV.Value,
+			Options:    mfCV.Options,
+			OptionsExt: mfCV.OptionsExt,
+			DataType:   mfCV.DataType,
+			IsList:     mfCV.IsList,
+			Delimiter:  mfCV.Delimiter,
+			UserInput:  mfCV.UserInput,
+			Optional:   mfCV.Optional,
+		}
+		for i, mfCT := range mfCV.Targets {
+			t := target{
+				section: services.SectionConfigs,
+				key:     ref,
+				path:    v1_model.Path{services.SectionConfigs, ref, "targets", i},
+				field:   "refVar",
+				name:    mfCT.RefVar,
+			}
+			applyTarget(&m, m.services, false, t, mfCT.Services, func(s *model.Service) *services.DuplicateTargetError {
+				return set(&s.Configs, mfCT.RefVar, ref)
+			})
+			applyTarget(&m, m.auxServices, true, t, mfCT.AuxServices, func(a *model.AuxService) *services.DuplicateTargetError {
+				return set(&a.Configs, mfCT.RefVar, ref)
+			})
+		}
+	}
+	if len(mf.Files) > 0 {
+		v2MF.Files = make(map[string]model.File)
+	}
+	for _, ref := range maputil.SortedKeys(mf.Files) {
+		mfF := mf.Files[ref]
+		v2MF.Files[ref] = model.File{Source: mfF.Source, Schema: mfF.Schema, UserInput: mfF.UserInput, Optional: mfF.Optional}
+		for i, mfFT := range mfF.Targets {
+			t := target{
+				section: services.SectionFiles,
+				key:     ref,
+				path:    v1_model.Path{services.SectionFiles, ref, "targets", i},
+				field:   "mountPoint",
+				name:    mfFT.MountPoint,
+			}
+			applyTarget(&m, m.services, false, t, mfFT.Services, func(s *model.Service) *services.DuplicateTargetError {
+				return set(&s.Files, mfFT.MountPoint, ref)
+			})
+		}
+	}
+	if len(mf.FileGroups) > 0 {
+		v2MF.FileGroups = make(map[string]model.FileGroup)
+	}
+	for _, ref := range maputil.SortedKeys(mf.FileGroups) {
+		mfFG := mf.FileGroups[ref]
+		v2MF.FileGroups[ref] = model.FileGroup{UserInput: mfFG.UserInput}
+		for i, mfFGT := range mfFG.Targets {
+			t := target{
+				section: services.SectionFileGroups,
+				key:     ref,
+				path:    v1_model.Path{services.SectionFileGroups, ref, "targets", i},
+				field:   "basePath",
+				name:    mfFGT.BasePath,
+			}
+			applyTarget(&m, m.services, false, t, mfFGT.Services, func(s *model.Service) *services.DuplicateTargetError {
+				return set(&s.FileGroups, mfFGT.BasePath, ref)
+			})
+		}
+	}
+	if len(m.errs) > 0 {
+		return model.ModFile{}, errors.Join(m.errs...)
+	}
+	if len(m.services) > 0 {
+		v2MF.Services = make(map[string]model.Service)
+		for ref, s := range m.services {
+			v2MF.Services[ref] = *s
+		}
+	}
+	if len(m.auxServices) > 0 {
+		v2MF.AuxServices = make(map[string]model.AuxService)
+		for ref, a := range m.auxServices {
+			v2MF.AuxServices[ref] = *a
+		}
+	}
+	return v2MF, nil
+}
+
+type migrator struct {
+	services    map[string]*model.Service
+	auxServices map[string]*model.AuxService
+	errs        []error
+}
+
+// target locates a target (mount point, reference variable or base path) of a top-level section element.
+// Errors use the sections and paths of the v1 setters, see services.SetVolumes.
+type target struct {
+	section string
+	key     string
+	path    v1_model.Path // path of the element declaring the target
+	field   string
+	name    string
+}
+
+// applyTarget applies f to the referenced services. Undefined services and targets claimed by other elements are
+// recorded as *services.UndefinedRefError and *services.DuplicateTargetError.
+func applyTarget[S any](m *migrator, srvs map[string]*S, aux bool, t target, refs []string, f func(s *S) *services.DuplicateTargetError) {
+	section := "services"
+	if aux {
+		section = "auxServices"
+	}
+	for j, ref := range refs {
+		s, ok := srvs[ref]
+		if !ok {
+			m.errs = append(m.errs, v1_model.NewError(append(slices.Clone(t.path), section, j), &services.UndefinedRefError{Section: t.section, Key: t.key, Ref: ref, Aux: aux, Target: t.name}))
+			continue
+		}
+		if err := f(s); err != nil {
+			err.Section, err.Key, err.Service, err.Aux, err.Target = t.section, t.key, ref, aux, t.name
+			m.errs = append(m.errs, v1_model.NewError(append(slices.Clone(t.path), t.field), err))
+		}
+	}
+}
+
+// set adds the value to the map, identical values for the same key are ignored. A different value is reported as
+// *services.DuplicateTargetError holding the existing element, the caller adds the remaining fields.
+func set[V comparable](m *map[string]V, key string, val V) *services.DuplicateTargetError {
+	if *m == nil {
+		*m = make(map[string]V)
+	}
+	if v, ok := (*m)[key]; ok {
+		if v == val {
+			return nil
+		}
+		err := &services.DuplicateTargetError{}
+		err.Existing, err.ExistingService = existing(v)
+		return err
+	}
+	(*m)[key] = val
+	return nil
+}
+
+// existing returns the key of the element a target value belongs to and, for module dependencies, the service.
+func existing(v any) (string, string) {
+	switch v := v.(type) {
+	case string:
+		return v, ""
+	case model.SrvReference:
+		return v.Ref, ""
+	case model.ExtDependency:
+		return v.ID, v.Service
+	case model.HostResourceMount:
+		return v.Ref, ""
+	case model.SecretTarget:
+		return v.Ref, ""
+	}
+	return "", ""
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/services"
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v2/model"
+)
+
+func TestMigrate(t *testing.T) {
+	mf := v1_model.ModFile{
+		ModfileVersion: v1_model.Version,
+		ID:             "github.com/user/test",
+		Services: map[string]v1_model.Service{
+			"a": {Name: "A", Image: "a:v1.0.0"},
+			"b": {Name: "B", Image: "b:v1.0.0"},
+		},
+		AuxServices: map[string]v1_model.AuxService{
+			"c": {Name: "C"},
+		},
+		ServiceReferences: map[string][]v1_model.DependencyTarget{
+			"a": {{RefVar: "A_HOST", Services: []string{"b"}, AuxServices: []string{"c"}}},
+		},
+		Volumes: map[string][]v1_model.VolumeTarget{
+			"vol": {{MountPoint: "/data", Services: []string{"a", "b"}}},
+		},
+		Secrets: map[string]v1_model.Secret{
+			"sec": {
+				Type: "api-key",
+				Targets: []v1_model.SecretTarget{
+					{MountPoint: "/sec", RefVar: "SEC", Services: []string{"a"}},
+				},
+			},
+		},
+	}
+	a := model.ModFile{
+		ModfileVersion: model.Version,
+		ID:             "github.com/user/test",
+		Services: map[string]model.Service{
+			"a": {
+				Name:         "A",
+				Image:        "a:v1.0.0",
+				Volumes:      map[string]string{"/data": "vol"},
+				SecretMounts: map[string]model.SecretTarget{"/sec": {Ref: "sec"}},
+				SecretVars:   map[string]model.SecretTarget{"SEC": {Ref: "sec"}},
+			},
+			"b": {
+				Name:          "B",
+				Image:         "b:v1.0.0",
+				Volumes:       map[string]string{"/data": "vol"},
+				SrvReferences: map[string]model.SrvReference{"A_HOST": {Ref: "a"}},
+			},
+		},
+		AuxServices: map[string]model.AuxService{
+			"c": {
+				Name:          "C",
+				SrvReferences: map[string]model.SrvReference{"A_HOST": {Ref: "a"}},
+			},
+		},
+		Volumes: []string{"vol"},
+		Secrets: map[string]model.Secret{"sec": {Type: "api-key"}},
+	}
+	b, err := Migrate(mf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, b) == false {
+		t.Errorf("%+v != %+v", a, b)
+	}
+	// ---------------------------
+	mf.Volumes["vol2"] = []v1_model.VolumeTarget{{MountPoint: "/data", Services: []string{"a", "d"}}}
+	if _, err = Migrate(mf); err == nil {
+		t.Error("err == nil")
+	} else if e, ok := err.(interface{ Unwrap() []error }); !ok {
+		t.Error("not a joined error")
+	} else if errs := e.Unwrap(); len(errs) != 2 {
+		t.Errorf("%d != 2", len(errs))
+	} else {
+		var dtErr *services.DuplicateTargetError
+		if !errors.As(errs[0], &dtErr) {
+			t.Error("not *DuplicateTargetError")
+		} else if dtErr.Section != services.SectionVolumes || dtErr.Key != "vol2" || dtErr.Existing != "vol" || dtErr.Service != "a" || dtErr.Target != "/data" {
+			t.Errorf("%+v", dtErr)
+		}
+		var urErr *services.UndefinedRefError
+		if !errors.As(errs[1], &urErr) {
+			t.Error("not *UndefinedRefError")
+		} else if urErr.Section != services.SectionVolumes || urErr.Key != "vol2" || urErr.Ref != "d" || urErr.Target != "/data" {
+			t.Errorf("%+v", urErr)
+		}
+		var mErr *v1_model.Error
+		for i, p := range []string{"volumes.vol2[0].mountPoint", "volumes.vol2[0].services[1]"} {
+			if !errors.As(errs[i], &mErr) {
+				t.Errorf("%d: not *Error", i)
+			} else if mErr.Path.String() != p {
+				t.Errorf("%s != %s", mErr.Path, p)
+			}
+		}
+	}
+}
@@ -0,0 +1,420 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migration
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/services"
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v2/model"
+	"gopkg.in/yaml.v3"
+)
+
+// MigrateYAML migrates a v1 modfile document to v2, see MigrateNode.
+func MigrateYAML(b []byte) ([]byte, error) {
+	var yn yaml.Node
+	if err := yaml.Unmarshal(b, &yn); err != nil {
+		return nil, err
+	}
+	if err := MigrateNode(&yn); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&yn); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MigrateNode rewrites a v1 modfile document in place to v2. Targets of the top-level sections are moved to the
+// services in document order, keys and comments are kept. Comments placed within removed target lists are
+// attached to the entries created from them. All problems are returned as a single joined error.
+func MigrateNode(yn *yaml.Node) error {
+	root := yn
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root.Kind != yaml.MappingNode {
+		return errors.New("invalid modfile: mapping required")
+	}
+	if _, v := mapGet(root, "modfileVersion"); v == nil || v.Value != v1_model.Version {
+		return errors.New("invalid modfile version: " + v1_model.Version + " required")
+	}
+	m := nodeMigrator{
+		services:    make(map[string]*serviceNode),
+		auxServices: make(map[string]*serviceNode),
+	}
+	if err := m.collect(root, "services", m.services); err != nil {
+		return err
+	}
+	if err := m.collect(root, "auxServices", m.auxServices); err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		k, v := root.Content[i], root.Content[i+1]
+		switch k.Value {
+		case "modfileVersion":
+			v.Value = model.Version
+		case "serviceReferences":
+			m.migrateSrvReferences(v)
+		case "volumes":
+			root.Content[i+1] = m.migrateVolumes(v)
+		case "dependencies":
+			m.migrateDependencies(v)
+		case "hostResources":
+			m.migrateHostResources(v)
+		case "secrets":
+			m.migrateSecrets(v)
+		case "configs":
+			m.migrateConfigs(v)
+		case "files":
+			m.migrateFiles(v)
+		case "fileGroups":
+			m.migrateFileGroups(v)
+		}
+	}
+	if len(m.errs) > 0 {
+		return errors.Join(m.errs...)
+	}
+	mapDelete(root, "serviceReferences")
+	return nil
+}
+
+// keyFields maps service sections to the target fields providing the keys of their entries.
+var keyFields = map[string]string{
+	"srvReferences":   "refVar",
+	"volumes":         "mountPoint",
+	"extDependencies": "refVar",
+	"hostResources":   "mountPoint",
+	"secretMounts":    "mountPoint",
+	"secretVars":      "refVar",
+	"configs":         "refVar",
+	"files":           "mountPoint",
+	"fileGroups":      "basePath",
+}
+
+type serviceNode struct {
+	node    *yaml.Node
+	entries map[string]map[string]any
+}
+
+type nodeMigrator struct {
+	services    map[string]*serviceNode
+	auxServices map[string]*serviceNode
+	errs        []error
+}
+
+func (m *nodeMigrator) collect(root *yaml.Node, key string, services map[string]*serviceNode) error {
+	_, v := mapGet(root, key)
+	if v == nil {
+		return nil
+	}
+	if v.Kind != yaml.MappingNode {
+		return nodeError(v, fmt.Errorf("invalid %s: mapping required", key))
+	}
+	for i := 0; i+1 < len(v.Content); i += 2 {
+		sn := v.Content[i+1]
+		if sn.Kind != yaml.MappingNode {
+			return nodeError(sn, fmt.Errorf("invalid service '%s': mapping required", v.Content[i].Value))
+		}
+		services[v.Content[i].Value] = &serviceNode{node: sn, entries: make(map[string]map[string]any)}
+	}
+	return nil
+}
+
+// add adds an entry to a section of the referenced services, identical entries are ignored. The element declaring
+// the target is identified by its v1 section and key, see services.UndefinedRefError.
+func (m *nodeMigrator) add(target *yaml.Node, v1Section, elemKey string, refs []string, aux bool, section, key string, val any, gen func() *yaml.Node) {
+	srvs := m.services
+	if aux {
+		srvs = m.auxServices
+	}
+	for _, ref := range refs {
+		s, ok := srvs[ref]
+		if !ok {
+			m.errs = append(m.errs, nodeError(target, &services.UndefinedRefError{Section: v1Section, Key: elemKey, Ref: ref, Aux: aux, Target: key}))
+			continue
+		}
+		entries, ok := s.entries[section]
+		if !ok {
+			entries = make(map[string]any)
+			s.entries[section] = entries
+		}
+		if v, ok := entries[key]; ok {
+			if v != val {
+				err := &services.DuplicateTargetError{Section: v1Section, Key: elemKey, Service: ref, Aux: aux, Target: key}
+				err.Existing, err.ExistingService = existing(v)
+				m.errs = append(m.errs, nodeError(target, err))
+			}
+			continue
+		}
+		entries[key] = val
+		_, sec := mapGet(s.node, section)
+		if sec == nil {
+			sec = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			s.node.Content = append(s.node.Content, scalar(section), sec)
+		}
+		kn := scalar(key)
+		kn.HeadComment = target.HeadComment
+		kn.LineComment = target.LineComment
+		kn.FootComment = target.FootComment
+		if fk, fv := mapGet(target, keyFields[section]); fk != nil && kn.LineComment == "" {
+			kn.LineComment = fv.LineComment
+		}
+		sec.Content = append(sec.Content, kn, gen())
+	}
+}
+
+func (m *nodeMigrator) decode(yn *yaml.Node, v any) bool {
+	if err := yn.Decode(v); err != nil {
+		m.errs = append(m.errs, err)
+		return false
+	}
+	return true
+}
+
+func (m *nodeMigrator) migrateSrvReferences(yn *yaml.Node) {
+	forEach(yn, func(ref string, targets *yaml.Node) {
+		forItems(targets, func(target *yaml.Node) {
+			var dt v1_model.DependencyTarget
+			if !m.decode(target, &dt) {
+				return
+			}
+			val := model.SrvReference{Ref: ref, Template: dt.Template}
+			gen := func() *yaml.Node {
+				return mapping("ref", ref, "template", dt.Template)
+			}
+			m.add(target, services.SectionServiceReferences, ref, dt.Services, false, "srvReferences", dt.RefVar, val, gen)
+			m.add(target, services.SectionServiceReferences, ref, dt.AuxServices, true, "srvReferences", dt.RefVar, val, gen)
+		})
+	})
+}
+
+func (m *nodeMigrator) migrateVolumes(yn *yaml.Node) *yaml.Node {
+	sn := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", HeadComment: yn.HeadComment, LineComment: yn.LineComment, FootComment: yn.FootComment}
+	for i := 0; i+1 < len(yn.Content); i += 2 {
+		k := yn.Content[i]
+		vn := scalar(k.Value)
+		vn.HeadComment = k.HeadComment
+		vn.LineComment = k.LineComment
+		vn.FootComment = k.FootComment
+		sn.Content = append(sn.Content, vn)
+	}
+	forEach(yn, func(ref string, targets *yaml.Node) {
+		forItems(targets, func(target *yaml.Node) {
+			var vt v1_model.VolumeTarget
+			if !m.decode(target, &vt) {
+				return
+			}
+			gen := func() *yaml.Node {
+				return scalar(ref)
+			}
+			m.add(target, services.SectionVolumes, ref, vt.Services, false, "volumes", vt.MountPoint, ref, gen)
+			m.add(target, services.SectionVolumes, ref, vt.AuxServices, true, "volumes", vt.MountPoint, ref, gen)
+		})
+	})
+	return sn
+}
+
+func (m *nodeMigrator) migrateDependencies(yn *yaml.Node) {
+	forEach(yn, func(id string, dep *yaml.Node) {
+		_, rs := mapGet(dep, "requiredServices")
+		if rs == nil {
+			return
+		}
+		forEach(rs, func(extRef string, targets *yaml.Node) {
+			forItems(targets, func(target *yaml.Node) {
+				var dt v1_model.DependencyTarget
+				if !m.decode(target, &dt) {
+					return
+				}
+				val := model.ExtDependency{ID: id, Service: extRef, Template: dt.Template}
+				gen := func() *yaml.Node {
+					return mapping("id", id, "service", extRef, "template", dt.Template)
+				}
+				m.add(target, services.SectionDependencies, id, dt.Services, false, "extDependencies", dt.RefVar, val, gen)
+				m.add(target, services.SectionDependencies, id, dt.AuxServices, true, "extDependencies", dt.RefVar, val, gen)
+			})
+		})
+		mapDelete(dep, "requiredServices")
+	})
+}
+
+func (m *nodeMigrator) migrateHostResources(yn *yaml.Node) {
+	m.migrateTargets(yn, func(ref string, target *yaml.Node) {
+		var rt v1_model.HostResourceTarget
+		if !m.decode(target, &rt) {
+			return
+		}
+		val := model.HostResourceMount{Ref: ref, ReadOnly: rt.ReadOnly}
+		m.add(target, services.SectionHostResources, ref, rt.Services, false, "hostResources", rt.MountPoint, val, func() *yaml.Node {
+			n := mapping("ref", ref)
+			if rt.ReadOnly {
+				n.Content = append(n.Content, scalar("readOnly"), &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"})
+			}
+			return n
+		})
+	})
+}
+
+func (m *nodeMigrator) migrateSecrets(yn *yaml.Node) {
+	m.migrateTargets(yn, func(ref string, target *yaml.Node) {
+		var st v1_model.SecretTarget
+		if !m.decode(target, &st) {
+			return
+		}
+		val := model.SecretTarget{Ref: ref, Item: st.Item}
+		gen := func() *yaml.Node {
+			return mapping("ref", ref, "item", st.Item)
+		}
+		if st.MountPoint != "" {
+			m.add(target, services.SectionSecrets, ref, st.Services, false, "secretMounts", st.MountPoint, val, gen)
+		}
+		if st.RefVar != "" {
+			m.add(target, services.SectionSecrets, ref, st.Services, false, "secretVars", st.RefVar, val, gen)
+		}
+	})
+}
+
+func (m *nodeMigrator) migrateConfigs(yn *yaml.Node) {
+	m.migrateTargets(yn, func(ref string, target *yaml.Node) {
+		var ct v1_model.ConfigTarget
+		if !m.decode(target, &ct) {
+			return
+		}
+		gen := func() *yaml.Node {
+			return scalar(ref)
+		}
+		m.add(target, services.SectionConfigs, ref, ct.Services, false, "configs", ct.RefVar, ref, gen)
+		m.add(target, services.SectionConfigs, ref, ct.AuxServices, true, "configs", ct.RefVar, ref, gen)
+	})
+}
+
+func (m *nodeMigrator) migrateFiles(yn *yaml.Node) {
+	m.migrateTargets(yn, func(ref string, target *yaml.Node) {
+		var ft v1_model.FileTarget
+		if !m.decode(target, &ft) {
+			return
+		}
+		m.add(target, services.SectionFiles, ref, ft.Services, false, "files", ft.MountPoint, ref, func() *yaml.Node {
+			return scalar(ref)
+		})
+	})
+}
+
+func (m *nodeMigrator) migrateFileGroups(yn *yaml.Node) {
+	m.migrateTargets(yn, func(ref string, target *yaml.Node) {
+		var fgt v1_model.FileGroupTarget
+		if !m.decode(target, &fgt) {
+			return
+		}
+		m.add(target, services.SectionFileGroups, ref, fgt.Services, false, "fileGroups", fgt.BasePath, ref, func() *yaml.Node {
+			return scalar(ref)
+		})
+	})
+}
+
+// migrateTargets calls f for every item of the 'targets' lists of a section and removes the lists.
+func (m *nodeMigrator) migrateTargets(yn *yaml.Node, f func(ref string, target *yaml.Node)) {
+	forEach(yn, func(ref string, def *yaml.Node) {
+		_, targets := mapGet(def, "targets")
+		if targets == nil {
+			return
+		}
+		forItems(targets, func(target *yaml.Node) {
+			f(ref, target)
+		})
+		mapDelete(def, "targets")
+	})
+}
+
+func forEach(yn *yaml.Node, f func(key string, val *yaml.Node)) {
+	yn = resolve(yn)
+	if yn.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(yn.Content); i += 2 {
+		f(yn.Content[i].Value, resolve(yn.Content[i+1]))
+	}
+}
+
+func forItems(yn *yaml.Node, f func(item *yaml.Node)) {
+	yn = resolve(yn)
+	if yn.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, item := range yn.Content {
+		f(resolve(item))
+	}
+}
+
+func mapGet(yn *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	yn = resolve(yn)
+	if yn.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(yn.Content); i += 2 {
+		if yn.Content[i].Value == key {
+			return yn.Content[i], resolve(yn.Content[i+1])
+		}
+	}
+	return nil, nil
+}
+
+func mapDelete(yn *yaml.Node, key string) {
+	yn = resolve(yn)
+	for i := 0; i+1 < len(yn.Content); i += 2 {
+		if yn.Content[i].Value == key {
+			yn.Content = append(yn.Content[:i], yn.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// mapping creates a mapping node from key value pairs, pairs with empty values are omitted.
+func mapping(kv ...string) *yaml.Node {
+	n := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i+1] != "" {
+			n.Content = append(n.Content, scalar(kv[i]), scalar(kv[i+1]))
+		}
+	}
+	return n
+}
+
+func scalar(s string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: s}
+}
+
+func resolve(yn *yaml.Node) *yaml.Node {
+	for yn.Kind == yaml.AliasNode {
+		yn = yn.Alias
+	}
+	return yn
+}
+
+func nodeError(yn *yaml.Node, err error) error {
+	return &v1_model.Error{Line: yn.Line, Column: yn.Column, Err: err}
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/v1/generator/services"
+)
+
+func TestMigrateYAML(t *testing.T) {
+	a := `# test module
+modfileVersion: v1
+id: github.com/user/test
+services:
+  # main service
+  a:
+    name: A
+    image: a:v1.0.0 # pinned
+  b:
+    name: B
+    image: b:v1.0.0
+volumes:
+  # persistent data
+  data:
+    # shared
+    - mountPoint: /data
+      services:
+        - a
+        - b
+configs:
+  level:
+    value: info
+    targets:
+      - refVar: LEVEL # log level
+        services:
+          - a
+serviceReferences:
+  a:
+    - refVar: A_HOST
+      services:
+        - b
+`
+	b := `# test module
+modfileVersion: v2
+id: github.com/user/test
+services:
+  # main service
+  a:
+    name: A
+    image: a:v1.0.0 # pinned
+    volumes:
+      # shared
+      /data: data
+    configs:
+      LEVEL: level # log level
+  b:
+    name: B
+    image: b:v1.0.0
+    volumes:
+      # shared
+      /data: data
+    srvReferences:
+      A_HOST:
+        ref: a
+volumes:
+  # persistent data
+  - data
+configs:
+  level:
+    value: info
+`
+	c, err := MigrateYAML([]byte(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(c) != b {
+		t.Errorf("%s != %s", c, b)
+	}
+	// ---------------------------
+	if _, err = MigrateYAML(c); err == nil {
+		t.Error("err == nil")
+	}
+	// ---------------------------
+	var urErr *services.UndefinedRefError
+	if _, err = MigrateYAML([]byte(a + "  c:\n    - refVar: C_HOST\n      services:\n        - c\n")); !errors.As(err, &urErr) {
+		t.Error("not *UndefinedRefError")
+	} else if urErr.Section != services.SectionServiceReferences || urErr.Key != "c" || urErr.Ref != "c" || urErr.Target != "C_HOST" {
+		t.Errorf("%+v", urErr)
+	}
+}
@@ -0,0 +1,216 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+)
+
+// Types without changes are shared with v1.
+type (
+	Port                  = v1_model.Port
+	ByteFmt               = v1_model.ByteFmt
+	Duration              = v1_model.Duration
+	StrOrSlice            = v1_model.StrOrSlice
+	FileMode              = v1_model.FileMode
+	RunConfig             = v1_model.RunConfig
+	BindMount             = v1_model.BindMount
+	TmpfsMount            = v1_model.TmpfsMount
+	HttpEndpoint          = v1_model.HttpEndpoint
+	HttpEndpointStrSub    = v1_model.HttpEndpointStrSub
+	HttpEndpointProxyConf = v1_model.HttpEndpointProxyConf
+	SrvPort               = v1_model.SrvPort
+	Resource              = v1_model.Resource
+	UserInput             = v1_model.UserInput
+	ConfigUserInput       = v1_model.ConfigUserInput
+	FileUserInput         = v1_model.FileUserInput
+	InputGroup            = v1_model.InputGroup
+)
+
+type ModFile struct {
+	ModfileVersion string `yaml:"modfileVersion" json:"modfileVersion"`
+	// url without schema (e.g. github.com/user/repo)
+	ID string `yaml:"id" json:"id"`
+	// module name
+	Name string `yaml:"name" json:"name"`
+	// short text describing the module
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// module tags
+	Tags []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	// module license name (e.g. Apache License 2.0)
+	License string `yaml:"license,omitempty" json:"license,omitempty"`
+	// module author
+	Author string `yaml:"author,omitempty" json:"author,omitempty"`
+	// module version (must be prefixed with 'v' and adhere to the semantic versioning guidelines, see https://semver.org/ for details)
+	Version string `yaml:"version" json:"version"`
+	// supported cpu architectures
+	Architectures []string `yaml:"architectures,omitempty" json:"architectures,omitempty" jsonschema:"enum=x86,enum=i386,enum=x86_64,enum=amd64,enum=aarch32,enum=arm32v5,enum=arm32v6,enum=arm32v7,enum=aarch64,enum=arm64v8"`
+	// map depicting the services the module consists of (keys serve as unique identifiers and can be reused elsewhere in the modfile to reference a service)
+	Services map[string]Service `yaml:"services" json:"services"`
+	// map containing auxiliary services that can be deployed by module services (keys serve as unique identifiers and can be reused elsewhere in the modfile to reference an aux service)
+	AuxServices map[string]AuxService `yaml:"auxServices,omitempty" json:"auxServices,omitempty"`
+	// list of image sources for aux services (e.g. ghcr.io/senergy-platform/*)
+	AuxImageSources []string `yaml:"auxImageSources,omitempty" json:"auxImageSources,omitempty"`
+	// volume names to be used by services
+	Volumes []string `yaml:"volumes,omitempty" json:"volumes,omitempty"`
+	// external modules required by the module (keys represent module IDs)
+	Dependencies map[string]ModuleDependency `yaml:"dependencies,omitempty" json:"dependencies,omitempty"`
+	// host resources required by services (e.g. devices, sockets, ...)
+	HostResources map[string]Resource `yaml:"hostResources,omitempty" json:"hostResources,omitempty"`
+	// secrets required by services (e.g. certs, keys, ...)
+	Secrets map[string]Secret `yaml:"secrets,omitempty" json:"secrets,omitempty"`
+	// configuration values required by services
+	Configs map[string]ConfigValue `yaml:"configs,omitempty" json:"configs,omitempty"`
+	// files that can be edited by the user and mounted by services
+	Files map[string]File `yaml:"files,omitempty" json:"files,omitempty"`
+	// group of arbitrary files that can be added by the user and mounted by services
+	FileGroups map[string]FileGroup `yaml:"fileGroups,omitempty" json:"fileGroups,omitempty"`
+	// map of groups for categorising user inputs (keys serve as unique identifiers and can be reused elsewhere in the modfile to reference a group)
+	InputGroups map[string]InputGroup `yaml:"inputGroups,omitempty" json:"inputGroups,omitempty"`
+}
+
+type Service struct {
+	// service name
+	Name string `yaml:"name" json:"name"`
+	// container image (must be versioned via tag or digest, e.g. srv-image:v1.0.0)
+	Image string `yaml:"image" json:"image"`
+	// configurations for running the service container (e.g. restart strategy, stop timeout, ...)
+	RunConfig RunConfig `yaml:"runConfig,omitempty" json:"runConfig,omitempty"`
+	// files or dictionaries to be mounted from module repository
+	Include []BindMount `yaml:"include,omitempty" json:"include,omitempty"`
+	// temporary file systems (in memory) required by the service
+	Tmpfs []TmpfsMount `yaml:"tmpfs,omitempty" json:"tmpfs,omitempty"`
+	// http endpoints of the service to be exposed via the api gateway
+	HttpEndpoints []HttpEndpoint `yaml:"httpEndpoints,omitempty" json:"httpEndpoints,omitempty"`
+	// service ports to be published on the host
+	Ports []SrvPort `yaml:"ports,omitempty" json:"ports,omitempty"`
+	// device cgroup rules applied to the service container (e.g. 'c 42:* rmw')
+	DeviceCGroupRules []string `yaml:"deviceCGroupRules,omitempty" json:"deviceCGroupRules,omitempty"`
+	// map linking mount points to volumes (volume names as defined in ModFile.Volumes serve as values)
+	Volumes map[string]string `yaml:"volumes,omitempty" json:"volumes,omitempty"`
+	// map linking mount points to host resources
+	HostResources map[string]HostResourceMount `yaml:"hostResources,omitempty" json:"hostResources,omitempty"`
+	// map linking mount points to secrets
+	SecretMounts map[string]SecretTarget `yaml:"secretMounts,omitempty" json:"secretMounts,omitempty"`
+	// map linking container environment variables to secret values
+	SecretVars map[string]SecretTarget `yaml:"secretVars,omitempty" json:"secretVars,omitempty"`
+	// map linking container environment variables to configuration values (identifiers as defined in ModFile.Configs serve as values)
+	Configs map[string]string `yaml:"configs,omitempty" json:"configs,omitempty"`
+	// map linking mount points to files (identifiers as defined in ModFile.Files serve as values)
+	Files map[string]string `yaml:"files,omitempty" json:"files,omitempty"`
+	// map linking base paths to file groups (identifiers as defined in ModFile.FileGroups serve as values)
+	FileGroups map[string]string `yaml:"fileGroups,omitempty" json:"fileGroups,omitempty"`
+	// map linking container environment variables to module services
+	SrvReferences map[string]SrvReference `yaml:"srvReferences,omitempty" json:"srvReferences,omitempty"`
+	// map linking container environment variables to services of external modules
+	ExtDependencies map[string]ExtDependency `yaml:"extDependencies,omitempty" json:"extDependencies,omitempty"`
+}
+
+type AuxService struct {
+	// service name
+	Name string `yaml:"name" json:"name"`
+	// default container image (optional), must be allowed by ModFile.AuxImageSources
+	// the image is checked but not part of the generated module as module_lib.AuxService has no image
+	Image string `yaml:"image,omitempty" json:"image,omitempty"`
+	// configurations for running the service container (e.g. restart strategy, stop timeout, ...)
+	RunConfig RunConfig `yaml:"runConfig,omitempty" json:"runConfig,omitempty"`
+	// files or dictionaries to be mounted from module repository
+	Include []BindMount `yaml:"include,omitempty" json:"include,omitempty"`
+	// temporary file systems (in memory) required by the service
+	Tmpfs []TmpfsMount `yaml:"tmpfs,omitempty" json:"tmpfs,omitempty"`
+	// map linking mount points to volumes (volume names as defined in ModFile.Volumes serve as values)
+	Volumes map[string]string `yaml:"volumes,omitempty" json:"volumes,omitempty"`
+	// map linking container environment variables to configuration values (identifiers as defined in ModFile.Configs serve as values)
+	Configs map[string]string `yaml:"configs,omitempty" json:"configs,omitempty"`
+	// map linking container environment variables to module services
+	SrvReferences map[string]SrvReference `yaml:"srvReferences,omitempty" json:"srvReferences,omitempty"`
+	// map linking container environment variables to services of external modules
+	ExtDependencies map[string]ExtDependency `yaml:"extDependencies,omitempty" json:"extDependencies,omitempty"`
+}
+
+type HostResourceMount struct {
+	// host resource identifier as used in ModFile.HostResources
+	Ref string `yaml:"ref" json:"ref"`
+	// if true resource will be mounted as read only
+	ReadOnly bool `yaml:"readOnly,omitempty" json:"readOnly,omitempty"`
+}
+
+type SecretTarget struct {
+	// secret identifier as used in ModFile.Secrets
+	Ref string `yaml:"ref" json:"ref"`
+	// optional item reference as defined by the secret type
+	Item string `yaml:"item,omitempty" json:"item,omitempty"`
+}
+
+type SrvReference struct {
+	// service identifier as used in ModFile.Services
+	Ref string `yaml:"ref" json:"ref"`
+	// string with '{ref}' placeholder if additional information is required (e.g. http://{ref}/api)
+	Template string `yaml:"template,omitempty" json:"template,omitempty"`
+}
+
+type ExtDependency struct {
+	// module ID as used in ModFile.Dependencies
+	ID string `yaml:"id" json:"id"`
+	// service identifier as defined in ModFile.Services of the required module
+	Service string `yaml:"service" json:"service"`
+	// string with '{ref}' placeholder if additional information is required (e.g. http://{ref}/api)
+	Template string `yaml:"template,omitempty" json:"template,omitempty"`
+}
+
+type ModuleDependency struct {
+	// version of required module (e.g. =v1.0.2, >v1.0.2., >=v1.0.2, >v1.0.2;<v2.1.3, ...)
+	Version string `yaml:"version" json:"version"`
+}
+
+type Secret struct {
+	Resource `yaml:",inline"`
+	// resource type as defined by external services managing resources (e.g. api-key, certificate, ...)
+	Type string `yaml:"type" json:"type" jsonschema:"enum=certificate,enum=basic-auth,enum=api-key,enum=client-id,enum=private-key"`
+}
+
+type ConfigValue struct {
+	// default configuration value or nil
+	Value any `yaml:"value" json:"value,omitempty" jsonschema:"oneof_type=string;number;boolean;array"`
+	// list of possible configuration values
+	Options []any `yaml:"options,omitempty" json:"options,omitempty"`
+	// if true a value not defined in options can be set (only required if options are provided)
+	OptionsExt bool `yaml:"optionsExt,omitempty" json:"optionsExt,omitempty"`
+	// data type of the configuration value (e.g. string, int, ...) (defaults to "string" if nil)
+	DataType *string `yaml:"dataType,omitempty" json:"dataType,omitempty" jsonschema:"enum=string,enum=float,enum=int,enum=bool"`
+	// set to true if multiple configuration values are required
+	IsList bool `yaml:"isList,omitempty" json:"isList,omitempty"`
+	// delimiter to be used for marshalling multiple configuration values (defaults to "," if nil)
+	Delimiter *string `yaml:"delimiter,omitempty" json:"delimiter,omitempty"`
+	// meta info for user input via gui (if nil a default value must be set)
+	UserInput *ConfigUserInput `yaml:"userInput,omitempty" json:"userInput,omitempty"`
+	Optional  bool             `yaml:"optional,omitempty" json:"optional,omitempty"`
+}
+
+type File struct {
+	// optional relative path in module repo to file with default content
+	Source string `yaml:"source,omitempty" json:"source,omitempty"`
+	// optional relative path in module repo to a JSON Schema the file content must satisfy (file type must be json or yaml)
+	Schema    string        `yaml:"schema,omitempty" json:"schema,omitempty"`
+	UserInput FileUserInput `yaml:"userInput" json:"userInput"`
+	// set if file can be empty (= no input by user)
+	Optional bool `yaml:"optional,omitempty" json:"optional,omitempty"`
+}
+
+type FileGroup struct {
+	UserInput UserInput `yaml:"userInput" json:"userInput"`
+}
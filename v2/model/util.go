@@ -0,0 +1,71 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	v1_model "github.com/SENERGY-Platform/mgw-modfile-lib/v1/model"
+)
+
+const Version = "v2"
+
+func (c ConfigValue) GetUserInput() *UserInput {
+	if c.UserInput != nil {
+		return &c.UserInput.UserInput
+	}
+	return nil
+}
+
+// MarshalYAML uses the v1 encoding which keeps whole floats from being encoded as integers.
+func (c ConfigValue) MarshalYAML() (any, error) {
+	return v1_model.ConfigValue{
+		Value:      c.Value,
+		Options:    c.Options,
+		OptionsExt: c.OptionsExt,
+		DataType:   c.DataType,
+		IsList:     c.IsList,
+		Delimiter:  c.Delimiter,
+		UserInput:  c.UserInput,
+		Optional:   c.Optional,
+	}, nil
+}
+
+// UnmarshalJSON uses the v1 decoding which decodes whole numbers as int to match the YAML decoder.
+func (c *ConfigValue) UnmarshalJSON(b []byte) error {
+	var cv v1_model.ConfigValue
+	if err := cv.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	*c = ConfigValue{
+		Value:      cv.Value,
+		Options:    cv.Options,
+		OptionsExt: cv.OptionsExt,
+		DataType:   cv.DataType,
+		IsList:     cv.IsList,
+		Delimiter:  cv.Delimiter,
+		UserInput:  cv.UserInput,
+		Optional:   cv.Optional,
+	}
+	return nil
+}
+
+func (f File) GetUserInput() UserInput {
+	return f.UserInput.UserInput
+}
+
+func (f FileGroup) GetUserInput() UserInput {
+	return f.UserInput
+}
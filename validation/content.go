@@ -0,0 +1,80 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	TypeGeneric = "generic"
+	TypeJson    = "json"
+	TypeYaml    = "yaml"
+)
+
+// ParseContent decodes file content according to the file type. Content of other types than
+// TypeJson and TypeYaml is not decoded and nil is returned. YAML timestamps are decoded as strings.
+func ParseContent(fileType string, b []byte) (any, error) {
+	var v any
+	switch fileType {
+	case TypeJson:
+		if err := json.Unmarshal(b, &v); err != nil {
+			return nil, fmt.Errorf("invalid json: %w", err)
+		}
+	case TypeYaml:
+		var yn yaml.Node
+		if err := yaml.Unmarshal(b, &yn); err != nil {
+			return nil, fmt.Errorf("invalid yaml: %w", err)
+		}
+		keepTimestamps(&yn)
+		if err := yn.Decode(&v); err != nil {
+			return nil, fmt.Errorf("invalid yaml: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// keepTimestamps tags timestamps as strings, so that they are decoded as written (e.g. 2024-01-01) and not as
+// time.Time, like in JSON content.
+func keepTimestamps(yn *yaml.Node) {
+	if yn.Kind == yaml.ScalarNode && yn.ShortTag() == "!!timestamp" {
+		yn.Tag = "!!str"
+	}
+	for _, c := range yn.Content {
+		keepTimestamps(c)
+	}
+}
+
+// ValidateContent checks that file content can be decoded according to the file type and, if a schema
+// is provided, satisfies the schema. Used for default content as well as content provided by users.
+func ValidateContent(fileType string, b []byte, schema *Schema) error {
+	v, err := ParseContent(fileType, b)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+	if fileType != TypeJson && fileType != TypeYaml {
+		return errors.New("schema validation requires json or yaml content")
+	}
+	return schema.Validate(v)
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateContent(t *testing.T) {
+	s, err := ParseSchema([]byte(`{"type": "object", "required": ["a"], "properties": {"a": {"type": "integer"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ValidateContent(TypeJson, []byte(`{"a": 1}`), s); err != nil {
+		t.Error(err)
+	}
+	if err = ValidateContent(TypeYaml, []byte("a: 1\n"), s); err != nil {
+		t.Error(err)
+	}
+	if err = ValidateContent(TypeJson, []byte(`{"a": 1}`), nil); err != nil {
+		t.Error(err)
+	}
+	if err = ValidateContent(TypeGeneric, []byte("{"), nil); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	if err = ValidateContent(TypeJson, []byte(`{"a": 1.5}`), s); err == nil {
+		t.Error("err == nil")
+	}
+	if err = ValidateContent(TypeYaml, []byte("b: 1\n"), s); err == nil {
+		t.Error("err == nil")
+	}
+	if err = ValidateContent(TypeJson, []byte(`{"a": 1`), nil); err == nil {
+		t.Error("err == nil")
+	}
+	if err = ValidateContent(TypeYaml, []byte("a: [\n"), nil); err == nil {
+		t.Error("err == nil")
+	}
+	if err = ValidateContent(TypeGeneric, []byte("test"), s); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestParseContent(t *testing.T) {
+	a := map[string]any{"d": "2024-01-01", "t": []any{"2024-01-01T10:00:00Z"}, "n": 1}
+	if b, err := ParseContent(TypeYaml, []byte("d: 2024-01-01\nt: [2024-01-01T10:00:00Z]\nn: 1\n")); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+	if b, err := ParseContent(TypeYaml, nil); err != nil {
+		t.Error(err)
+	} else if b != nil {
+		t.Errorf("%v != nil", b)
+	}
+	// ---------------------------
+	s, err := ParseSchema([]byte(`{"type": "object", "properties": {"d": {"type": "string"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ValidateContent(TypeYaml, []byte("d: 2024-01-01\n"), s); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,351 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SENERGY-Platform/mgw-modfile-lib/internal/maputil"
+)
+
+// Schema is a JSON Schema limited to the keywords needed to describe configuration files: type, enum, const,
+// properties, required, additionalProperties, items, minItems, maxItems, minLength, maxLength, pattern, minimum
+// and maximum. Annotations (e.g. title, description, default) are accepted. Other keywords, including
+// combinations (allOf, anyOf, oneOf, not) and references ($ref), are rejected by ParseSchema so that no
+// constraint is silently skipped.
+type Schema struct {
+	root     any
+	patterns map[string]*regexp.Regexp
+}
+
+// SchemaError describes a value not satisfying a schema. Path is a JSON pointer to the value.
+type SchemaError struct {
+	Path string
+	Err  error
+}
+
+func (e *SchemaError) Error() string {
+	p := e.Path
+	if p == "" {
+		p = "/"
+	}
+	return fmt.Sprintf("'%s': %s", p, e.Err)
+}
+
+func (e *SchemaError) Unwrap() error {
+	return e.Err
+}
+
+// ParseSchema parses a JSON encoded schema. Unsupported keywords and invalid keyword values are rejected.
+func ParseSchema(b []byte) (*Schema, error) {
+	var root any
+	if err := json.Unmarshal(b, &root); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	s := &Schema{root: root, patterns: make(map[string]*regexp.Regexp)}
+	if err := s.compile(root, ""); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return s, nil
+}
+
+// Validate checks v against the schema. Values must be of the types produced by decoding JSON or YAML into
+// an empty interface, time.Time values are validated as RFC 3339 strings. All problems are returned as
+// *SchemaError.
+func (s *Schema) Validate(v any) error {
+	var errs []error
+	s.validate(s.root, normalize(v), "", &errs)
+	return errors.Join(errs...)
+}
+
+var annotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true, "default": true,
+	"examples": true, "deprecated": true, "readOnly": true, "writeOnly": true,
+}
+
+var types = []string{"null", "boolean", "object", "array", "number", "string", "integer"}
+
+// compile checks the subschema at the JSON pointer ptr.
+func (s *Schema) compile(node any, ptr string) error {
+	n, ok := node.(map[string]any)
+	if !ok {
+		if _, ok := node.(bool); !ok {
+			return fmt.Errorf("'#%s': schema must be an object or boolean, got %T", ptr, node)
+		}
+		return nil
+	}
+	for _, key := range maputil.SortedKeys(n) {
+		kPtr := ptr + "/" + escape(key)
+		if err := s.compileKeyword(key, n[key], ptr, kPtr); err != nil {
+			return fmt.Errorf("'#%s': %w", kPtr, err)
+		}
+	}
+	return nil
+}
+
+func (s *Schema) compileKeyword(key string, val any, ptr, kPtr string) error {
+	switch key {
+	case "type":
+		if sl, ok := val.([]any); ok {
+			for _, item := range sl {
+				if t, ok := item.(string); !ok || !slices.Contains(types, t) {
+					return fmt.Errorf("invalid type '%v'", item)
+				}
+			}
+			return nil
+		}
+		if t, ok := val.(string); !ok || !slices.Contains(types, t) {
+			return fmt.Errorf("invalid type '%v'", val)
+		}
+	case "enum":
+		if _, ok := val.([]any); !ok {
+			return errors.New("must be an array")
+		}
+	case "required":
+		sl, ok := val.([]any)
+		if !ok {
+			return errors.New("must be an array")
+		}
+		for _, item := range sl {
+			if _, ok := item.(string); !ok {
+				return errors.New("must contain strings")
+			}
+		}
+	case "properties":
+		m, ok := val.(map[string]any)
+		if !ok {
+			return errors.New("must be an object")
+		}
+		for _, name := range maputil.SortedKeys(m) {
+			if err := s.compile(m[name], kPtr+"/"+escape(name)); err != nil {
+				return err
+			}
+		}
+	case "items", "additionalProperties":
+		return s.compile(val, kPtr)
+	case "minItems", "maxItems", "minLength", "maxLength":
+		if f, ok := number(val); !ok || f < 0 || f != math.Trunc(f) {
+			return errors.New("must be a non-negative integer")
+		}
+	case "minimum", "maximum":
+		if _, ok := number(val); !ok {
+			return errors.New("must be a number")
+		}
+	case "pattern":
+		p, ok := val.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return err
+		}
+		s.patterns[p] = re
+	case "const":
+	default:
+		if !annotations[key] {
+			return fmt.Errorf("unsupported keyword '%s'", key)
+		}
+		if key == "$id" && ptr != "" {
+			return errors.New("only allowed at the root")
+		}
+	}
+	return nil
+}
+
+func escape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func (s *Schema) validate(node any, v any, path string, errs *[]error) {
+	addErr := func(format string, args ...any) {
+		*errs = append(*errs, &SchemaError{Path: path, Err: fmt.Errorf(format, args...)})
+	}
+	n, ok := node.(map[string]any)
+	if !ok {
+		if b, _ := node.(bool); !b {
+			addErr("not allowed")
+		}
+		return
+	}
+	if t, ok := n["type"]; ok && !matchesType(t, v) {
+		addErr("expected type %s, got %s", formatType(t), typeOf(v))
+		return
+	}
+	if e, ok := n["enum"].([]any); ok && !slices.ContainsFunc(e, func(item any) bool { return equal(item, v) }) {
+		addErr("value not in enum")
+	}
+	if c, ok := n["const"]; ok && !equal(c, v) {
+		addErr("value does not match const")
+	}
+	switch val := v.(type) {
+	case map[string]any:
+		s.validateObject(n, val, path, errs)
+	case []any:
+		if m, ok := number(n["minItems"]); ok && float64(len(val)) < m {
+			addErr("%d items, expected at least %v", len(val), m)
+		}
+		if m, ok := number(n["maxItems"]); ok && float64(len(val)) > m {
+			addErr("%d items, expected at most %v", len(val), m)
+		}
+		if c, ok := n["items"]; ok {
+			for i, item := range val {
+				s.validate(c, item, path+"/"+strconv.Itoa(i), errs)
+			}
+		}
+	case string:
+		l := utf8.RuneCountInString(val)
+		if m, ok := number(n["minLength"]); ok && float64(l) < m {
+			addErr("length %d less than %v", l, m)
+		}
+		if m, ok := number(n["maxLength"]); ok && float64(l) > m {
+			addErr("length %d greater than %v", l, m)
+		}
+		if p, ok := n["pattern"].(string); ok && !s.patterns[p].MatchString(val) {
+			addErr("value does not match pattern '%s'", p)
+		}
+	case float64:
+		if m, ok := number(n["minimum"]); ok && val < m {
+			addErr("%v less than %v", val, m)
+		}
+		if m, ok := number(n["maximum"]); ok && val > m {
+			addErr("%v greater than %v", val, m)
+		}
+	}
+}
+
+func (s *Schema) validateObject(n map[string]any, val map[string]any, path string, errs *[]error) {
+	if req, ok := n["required"].([]any); ok {
+		for _, r := range req {
+			if key, ok := r.(string); ok {
+				if _, ok := val[key]; !ok {
+					*errs = append(*errs, &SchemaError{Path: path, Err: fmt.Errorf("missing property '%s'", key)})
+				}
+			}
+		}
+	}
+	props, _ := n["properties"].(map[string]any)
+	add, hasAdd := n["additionalProperties"]
+	for _, key := range maputil.SortedKeys(val) {
+		p := path + "/" + escape(key)
+		if c, ok := props[key]; ok {
+			s.validate(c, val[key], p, errs)
+		} else if hasAdd {
+			s.validate(add, val[key], p, errs)
+		}
+	}
+}
+
+func matchesType(t any, v any) bool {
+	switch tv := t.(type) {
+	case string:
+		vt := typeOf(v)
+		return vt == tv || (tv == "number" && vt == "integer")
+	case []any:
+		return slices.ContainsFunc(tv, func(item any) bool { return matchesType(item, v) })
+	}
+	return true
+}
+
+func formatType(t any) string {
+	if sl, ok := t.([]any); ok {
+		var types []string
+		for _, item := range sl {
+			types = append(types, fmt.Sprint(item))
+		}
+		return strings.Join(types, " or ")
+	}
+	return fmt.Sprint(t)
+}
+
+func typeOf(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if val == math.Trunc(val) && !math.IsInf(val, 0) {
+			return "integer"
+		}
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func number(v any) (float64, bool) {
+	f, ok := v.(float64)
+	return f, ok
+}
+
+func equal(a, b any) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// normalize converts numbers to float64, timestamps to strings and map keys to strings, as produced by decoding JSON.
+func normalize(v any) any {
+	switch val := v.(type) {
+	case int:
+		return float64(val)
+	case int64:
+		return float64(val)
+	case uint64:
+		return float64(val)
+	case float32:
+		return float64(val)
+	case json.Number:
+		f, _ := val.Float64()
+		return f
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	case []any:
+		sl := make([]any, len(val))
+		for i, item := range val {
+			sl[i] = normalize(item)
+		}
+		return sl
+	case map[string]any:
+		m := make(map[string]any, len(val))
+		for key, item := range val {
+			m[key] = normalize(item)
+		}
+		return m
+	case map[any]any:
+		m := make(map[string]any, len(val))
+		for key, item := range val {
+			m[fmt.Sprint(key)] = normalize(item)
+		}
+		return m
+	}
+	return v
+}
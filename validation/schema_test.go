@@ -0,0 +1,158 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+const testSchema = `{
+  "type": "object",
+  "required": ["name", "port"],
+  "additionalProperties": false,
+  "properties": {
+    "name": {"type": "string", "minLength": 1, "maxLength": 8, "pattern": "^[a-z]+$"},
+    "port": {"type": "integer", "minimum": 1, "maximum": 65535},
+    "level": {"enum": ["debug", "info"]},
+    "ratio": {"type": "number", "minimum": 0, "maximum": 1},
+    "tags": {"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 3},
+    "mode": {"type": ["integer", "string"]},
+    "host": {"type": ["string", "null"]},
+    "path": {"const": "/data"},
+    "since": {"type": "string", "pattern": "^2024-"},
+    "extra": true
+  }
+}`
+
+func TestSchema_Validate(t *testing.T) {
+	s, err := ParseSchema([]byte(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := map[string]any{
+		"name":  "test",
+		"port":  8080,
+		"level": "info",
+		"ratio": 0.5,
+		"tags":  []any{"a", "b"},
+		"mode":  "auto",
+		"host":  nil,
+		"path":  "/data",
+		"since": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"extra": map[string]any{"a": 1},
+	}
+	if err = s.Validate(valid); err != nil {
+		t.Error(err)
+	}
+	// ---------------------------
+	invalid := map[string]any{
+		"name":    "Test",
+		"port":    0,
+		"level":   "warn",
+		"ratio":   1.5,
+		"tags":    []any{"a", "a", 1, "b"},
+		"mode":    1.5,
+		"host":    1,
+		"path":    "/",
+		"unknown": true,
+	}
+	err = s.Validate(invalid)
+	je, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors")
+	}
+	paths := map[string]int{}
+	for _, e := range je.Unwrap() {
+		var sErr *SchemaError
+		if !errors.As(e, &sErr) {
+			t.Fatal("not *SchemaError")
+		}
+		paths[sErr.Path]++
+	}
+	a := map[string]int{
+		"/name":    1,
+		"/port":    1,
+		"/level":   1,
+		"/ratio":   1,
+		"/tags":    1,
+		"/tags/2":  1,
+		"/mode":    1,
+		"/host":    1,
+		"/path":    1,
+		"/unknown": 1,
+	}
+	for p, n := range a {
+		if paths[p] != n {
+			t.Errorf("%s: %d != %d", p, paths[p], n)
+		}
+	}
+	if len(paths) != len(a) {
+		t.Errorf("%v", paths)
+	}
+	// ---------------------------
+	if err = s.Validate(map[string]any{"name": "test"}); err == nil {
+		t.Error("err == nil")
+	} else if err.Error() != "'/': missing property 'port'" {
+		t.Errorf("%s != '/': missing property 'port'", err)
+	}
+	// ---------------------------
+	if err = s.Validate([]any{}); err == nil {
+		t.Error("err == nil")
+	}
+}
+
+func TestParseSchema(t *testing.T) {
+	for _, b := range []string{
+		`[]`,
+		`{"pattern": "["}`,
+		`{"properties": {"a": 1}}`,
+		`{"type": "text"}`,
+		`{"required": "a"}`,
+		`{"minLength": -1}`,
+		`{"minimum": "1"}`,
+		`{"format": "email"}`,
+		`{"patternProperties": {"^a": {}}}`,
+		`{"prefixItems": [{}]}`,
+		`{"properties": {"a": {"$id": "a"}}}`,
+		`{"items": {"uniqueItems": true}}`,
+		`invalid`,
+	} {
+		if _, err := ParseSchema([]byte(b)); err == nil {
+			t.Errorf("%s: err == nil", b)
+		}
+	}
+	for _, kw := range []string{"$ref", "$defs", "allOf", "anyOf", "oneOf", "not", "exclusiveMinimum"} {
+		if _, err := ParseSchema([]byte(`{"` + kw + `": {}}`)); err == nil {
+			t.Errorf("%s: err == nil", kw)
+		} else if a := "invalid schema: '#/" + escape(kw) + "': unsupported keyword '" + kw + "'"; err.Error() != a {
+			t.Errorf("%s != %s", err, a)
+		}
+	}
+	// ---------------------------
+	s, err := ParseSchema([]byte(`{"$id": "https://example.org/s.json", "title": "T", "type": "array", "items": {"type": "array"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Validate([]any{[]any{}, []any{[]any{}}}); err != nil {
+		t.Error(err)
+	}
+	if err = s.Validate([]any{1}); err == nil {
+		t.Error("err == nil")
+	}
+}